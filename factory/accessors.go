@@ -0,0 +1,63 @@
+package factory
+
+import (
+	"github.com/senzing/g2-sdk-go/g2api"
+)
+
+// ----------------------------------------------------------------------------
+// Accessors
+// ----------------------------------------------------------------------------
+
+// The accessors below return an object already built by the matching GetG2* method, without
+// attempting to create it.  They suit hot paths that would otherwise call GetG2* repeatedly.
+// The boolean is false if the object has not been built, or was discarded by Destroy or Reset.
+
+// G2config returns the G2config built by GetG2config, if any.
+func (factory *SdkAbstractFactoryImpl) G2config() (g2api.G2config, bool) {
+	factory.modeMutex.RLock()
+	defer factory.modeMutex.RUnlock()
+	if !factory.g2configSyncOnce.isDone() {
+		return nil, false
+	}
+	return factory.g2configSingleton, true
+}
+
+// G2configmgr returns the G2configmgr built by GetG2configmgr, if any.
+func (factory *SdkAbstractFactoryImpl) G2configmgr() (g2api.G2configmgr, bool) {
+	factory.modeMutex.RLock()
+	defer factory.modeMutex.RUnlock()
+	if !factory.g2configmgrSyncOnce.isDone() {
+		return nil, false
+	}
+	return factory.g2configmgrSingleton, true
+}
+
+// G2diagnostic returns the G2diagnostic built by GetG2diagnostic, if any.
+func (factory *SdkAbstractFactoryImpl) G2diagnostic() (g2api.G2diagnostic, bool) {
+	factory.modeMutex.RLock()
+	defer factory.modeMutex.RUnlock()
+	if !factory.g2diagnosticSyncOnce.isDone() {
+		return nil, false
+	}
+	return factory.g2diagnosticSingleton, true
+}
+
+// G2engine returns the G2engine built by GetG2engine or GetG2engineWithConfigID, if any.
+func (factory *SdkAbstractFactoryImpl) G2engine() (g2api.G2engine, bool) {
+	factory.modeMutex.RLock()
+	defer factory.modeMutex.RUnlock()
+	if !factory.g2engineSyncOnce.isDone() {
+		return nil, false
+	}
+	return factory.g2engineSingleton, true
+}
+
+// G2product returns the G2product built by GetG2product, if any.
+func (factory *SdkAbstractFactoryImpl) G2product() (g2api.G2product, bool) {
+	factory.modeMutex.RLock()
+	defer factory.modeMutex.RUnlock()
+	if !factory.g2productSyncOnce.isDone() {
+		return nil, false
+	}
+	return factory.g2productSingleton, true
+}
@@ -0,0 +1,79 @@
+package factory
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSdkAbstractFactoryImpl_G2engine(test *testing.T) {
+	ctx := context.TODO()
+	testObject := &SdkAbstractFactoryImpl{
+		GrpcAddress: "localhost:8258",
+	}
+	_, ok := testObject.G2engine()
+	assert.False(test, ok, "not built yet")
+	g2engine, err := testObject.GetG2engine(ctx)
+	testError(test, ctx, err)
+	actual, ok := testObject.G2engine()
+	assert.True(test, ok)
+	assert.Same(test, g2engine, actual)
+	_, ok = testObject.G2product()
+	assert.False(test, ok, "only G2engine was built")
+	testError(test, ctx, testObject.Destroy(ctx))
+	_, ok = testObject.G2engine()
+	assert.False(test, ok, "discarded by Destroy")
+}
+
+// ----------------------------------------------------------------------------
+// Benchmarks
+// ----------------------------------------------------------------------------
+
+func BenchmarkSdkAbstractFactoryImpl_GetG2engine(benchmark *testing.B) {
+	ctx := context.TODO()
+	testObject := getTestObjectWithG2engine(&destroyG2engine{})
+	benchmark.ResetTimer()
+	for i := 0; i < benchmark.N; i++ {
+		testObject.GetG2engine(ctx)
+	}
+}
+
+func BenchmarkSdkAbstractFactoryImpl_G2engine(benchmark *testing.B) {
+	testObject := getTestObjectWithG2engine(&destroyG2engine{})
+	benchmark.ResetTimer()
+	for i := 0; i < benchmark.N; i++ {
+		testObject.G2engine()
+	}
+}
+
+func TestSdkAbstractFactoryImpl_G2engine_concurrentReset(test *testing.T) {
+	ctx := context.TODO()
+	testObject := &SdkAbstractFactoryImpl{NullBackend: true}
+	var waitGroup sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		waitGroup.Add(2)
+		go func() {
+			defer waitGroup.Done()
+			for j := 0; j < 100; j++ {
+				_, err := testObject.GetG2engine(ctx)
+				assert.NoError(test, err)
+				if g2engine, isBuilt := testObject.G2engine(); isBuilt {
+					assert.NotNil(test, g2engine)
+				}
+			}
+		}()
+		go func() {
+			defer waitGroup.Done()
+			for j := 0; j < 100; j++ {
+				assert.NoError(test, testObject.Reset(ctx, j%2 == 0))
+			}
+		}()
+	}
+	waitGroup.Wait()
+}
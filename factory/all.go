@@ -0,0 +1,69 @@
+package factory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/senzing/g2-sdk-go/g2api"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// G2Objects bundles the five Senzing objects returned by GetAll.
+type G2Objects struct {
+	G2config     g2api.G2config
+	G2configmgr  g2api.G2configmgr
+	G2diagnostic g2api.G2diagnostic
+	G2engine     g2api.G2engine
+	G2product    g2api.G2product
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Get every Senzing object from factory in dependency order: the configuration objects first,
+// because the others need a configuration in the Senzing repository.  Stops at the first error,
+// prefixing it with the name of the object that failed.
+func getAll(ctx context.Context, factory SdkAbstractFactory) (*G2Objects, error) {
+	var err error
+	result := &G2Objects{}
+	if result.G2config, err = factory.GetG2config(ctx); err != nil {
+		return nil, fmt.Errorf("G2config: %w", err)
+	}
+	if result.G2configmgr, err = factory.GetG2configmgr(ctx); err != nil {
+		return nil, fmt.Errorf("G2configmgr: %w", err)
+	}
+	if result.G2diagnostic, err = factory.GetG2diagnostic(ctx); err != nil {
+		return nil, fmt.Errorf("G2diagnostic: %w", err)
+	}
+	if result.G2engine, err = factory.GetG2engine(ctx); err != nil {
+		return nil, fmt.Errorf("G2engine: %w", err)
+	}
+	if result.G2product, err = factory.GetG2product(ctx); err != nil {
+		return nil, fmt.Errorf("G2product: %w", err)
+	}
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Interface methods
+// ----------------------------------------------------------------------------
+
+/*
+The GetAll method returns all five Senzing objects, created as by the GetG2* methods,
+in the order G2config, G2configmgr, G2diagnostic, G2engine, G2product.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The Senzing objects.
+  - The first error encountered, prefixed with the name of the object that failed;
+    objects after it are not created.
+*/
+func (factory *SdkAbstractFactoryImpl) GetAll(ctx context.Context) (*G2Objects, error) {
+	return getAll(factory.getContext(ctx), factory)
+}
@@ -0,0 +1,51 @@
+package factory
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSdkAbstractFactoryImpl_GetAll(test *testing.T) {
+	ctx := context.TODO()
+	testObject := &SdkAbstractFactoryImpl{NullBackend: true}
+	actual, err := testObject.GetAll(ctx)
+	testError(test, ctx, err)
+	assert.NotNil(test, actual.G2config)
+	assert.NotNil(test, actual.G2configmgr)
+	assert.NotNil(test, actual.G2diagnostic)
+	assert.NotNil(test, actual.G2engine)
+	assert.NotNil(test, actual.G2product)
+	assert.Len(test, testObject.CreatedObjects(ctx), 5)
+}
+
+func TestSdkAbstractFactoryImpl_GetAll_failure(test *testing.T) {
+	ctx := context.TODO()
+	dialCount := 0
+	testObject := &SdkAbstractFactoryImpl{
+		GrpcDialer: func(ctx context.Context) (*grpc.ClientConn, error) {
+			dialCount++
+			return nil, errors.New("server restarting")
+		},
+	}
+	testObject.g2configSyncOnce.Do(func() error {
+		testObject.g2configSingleton = &healthG2config{}
+		return nil
+	})
+	testObject.g2configmgrSyncOnce.Do(func() error {
+		testObject.g2configmgrSingleton = &healthG2configmgr{}
+		return nil
+	})
+	actual, err := testObject.GetAll(ctx)
+	assert.Nil(test, actual)
+	assert.ErrorIs(test, err, ErrGrpcDial)
+	assert.ErrorContains(test, err, "G2diagnostic: ")
+	assert.Equal(test, 1, dialCount, "GetAll stops at the first failure")
+}
@@ -0,0 +1,85 @@
+package factory
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Backoff chooses how long to wait before retrying a failed creation of the gRPC connection.
+// See WithCreationBackoff.
+type Backoff interface {
+	// Next returns the wait after attempt failed attempts, starting at 1.
+	Next(attempt int) time.Duration
+}
+
+// BackoffFunc adapts a function to the Backoff interface, for custom strategies.
+type BackoffFunc func(attempt int) time.Duration
+
+// ConstantBackoff waits the same duration before every retry.
+type ConstantBackoff time.Duration
+
+// ExponentialBackoff waits Base before the first retry, multiplying the wait by Multiplier,
+// 2 if it is not positive, before each further one, up to Max if it is positive.
+// Each wait is then shortened by a random fraction of up to Jitter, between 0 and 1,
+// so that clients that failed together do not retry in lockstep.
+type ExponentialBackoff struct {
+	Base       time.Duration
+	Jitter     float64
+	Max        time.Duration
+	Multiplier float64
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Jitter of the ExponentialBackoff used when CreationBackoff is nil.
+const defaultCreationBackoffJitter = 0.2
+
+// ----------------------------------------------------------------------------
+// Interface methods
+// ----------------------------------------------------------------------------
+
+func (backoff BackoffFunc) Next(attempt int) time.Duration {
+	return backoff(attempt)
+}
+
+func (backoff ConstantBackoff) Next(attempt int) time.Duration {
+	return time.Duration(backoff)
+}
+
+func (backoff ExponentialBackoff) Next(attempt int) time.Duration {
+	multiplier := backoff.Multiplier
+	if multiplier <= 0 {
+		multiplier = 2
+	}
+	result := float64(backoff.Base)
+	for i := 1; i < attempt && result < math.MaxInt64; i++ {
+		result *= multiplier
+	}
+	if backoff.Max > 0 && result > float64(backoff.Max) {
+		result = float64(backoff.Max)
+	}
+	result -= result * math.Min(math.Max(backoff.Jitter, 0), 1) * rand.Float64()
+	if result >= math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return time.Duration(result)
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Return CreationBackoff or, when it is nil, exponential backoff with jitter from GrpcRetryBackoff.
+func (factory *SdkAbstractFactoryImpl) getCreationBackoff() Backoff {
+	if factory.CreationBackoff == nil {
+		return ExponentialBackoff{Base: factory.GrpcRetryBackoff, Jitter: defaultCreationBackoffJitter}
+	}
+	return factory.CreationBackoff
+}
@@ -0,0 +1,84 @@
+package factory
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestExponentialBackoff_Next(test *testing.T) {
+	testCases := []struct {
+		name     string
+		backoff  ExponentialBackoff
+		expected []time.Duration
+	}{
+		{name: "doubling", backoff: ExponentialBackoff{Base: time.Second}, expected: []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second}},
+		{name: "multiplier", backoff: ExponentialBackoff{Base: time.Second, Multiplier: 3}, expected: []time.Duration{time.Second, 3 * time.Second, 9 * time.Second}},
+		{name: "max", backoff: ExponentialBackoff{Base: time.Second, Max: 3 * time.Second}, expected: []time.Duration{time.Second, 2 * time.Second, 3 * time.Second, 3 * time.Second}},
+	}
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func(test *testing.T) {
+			for i, expected := range testCase.expected {
+				assert.Equal(test, expected, testCase.backoff.Next(i+1), "attempt %d", i+1)
+			}
+		})
+	}
+	assert.Equal(test, time.Duration(math.MaxInt64), ExponentialBackoff{Base: time.Second}.Next(1000), "the wait must not overflow")
+}
+
+func TestExponentialBackoff_Next_jitter(test *testing.T) {
+	backoff := ExponentialBackoff{Base: time.Second, Jitter: 0.5}
+	for i := 0; i < 100; i++ {
+		actual := backoff.Next(2)
+		assert.GreaterOrEqual(test, actual, time.Second)
+		assert.LessOrEqual(test, actual, 2*time.Second)
+	}
+}
+
+func TestConstantBackoff_Next(test *testing.T) {
+	assert.Equal(test, time.Minute, ConstantBackoff(time.Minute).Next(1))
+	assert.Equal(test, time.Minute, ConstantBackoff(time.Minute).Next(10))
+}
+
+func TestBackoffFunc_Next(test *testing.T) {
+	backoff := BackoffFunc(func(attempt int) time.Duration { return time.Duration(attempt) * time.Second })
+	assert.Equal(test, 3*time.Second, backoff.Next(3))
+}
+
+func TestWithCreationBackoff(test *testing.T) {
+	ctx := context.TODO()
+	clock := newFakeClock(time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC))
+	var attempts int32
+	dialer := func(ctx context.Context) (*grpc.ClientConn, error) {
+		atomic.AddInt32(&attempts, 1)
+		return nil, errors.New("connection refused")
+	}
+	testObject, err := New(WithGrpcDialer(dialer), WithRetry(3, time.Hour), WithCreationBackoff(ConstantBackoff(time.Minute)), WithClock(clock))
+	testError(test, ctx, err)
+	result := make(chan error, 1)
+	go func() {
+		_, err := testObject.GetG2engine(ctx)
+		result <- err
+	}()
+	assert.Equal(test, time.Minute, clock.awaitWaiter(test))
+	clock.Advance(time.Minute)
+	assert.Equal(test, time.Minute, clock.awaitWaiter(test), "the backoff must replace WithRetry's")
+	clock.Advance(time.Minute)
+	assert.ErrorIs(test, <-result, ErrGrpcDial)
+	assert.Equal(test, int32(3), atomic.LoadInt32(&attempts))
+}
+
+func TestWithCreationBackoff_nil(test *testing.T) {
+	assert.Error(test, WithCreationBackoff(nil)(&SdkAbstractFactoryImpl{}))
+	assert.Equal(test, ExponentialBackoff{Base: time.Second, Jitter: defaultCreationBackoffJitter}, (&SdkAbstractFactoryImpl{GrpcRetryBackoff: time.Second}).getCreationBackoff())
+}
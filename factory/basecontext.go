@@ -0,0 +1,57 @@
+package factory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Bind the objects about to be created to BaseContext, if any: once BaseContext is done,
+// a watcher destroys them as Destroy would.  Destroy and Reset stop the watcher by cancelling
+// the context derived from BaseContext, so a factory that is destroyed and used again
+// starts a new watcher.  Each watcher belongs to one generation of objects: one that wakes
+// after a Destroy or Reset, even if BaseContext was done first, leaves the factory alone.
+// Called by the GetG2* methods with modeMutex read-locked.
+func (factory *SdkAbstractFactoryImpl) watchBaseContext() error {
+	baseContext := factory.BaseContext
+	if baseContext == nil {
+		return nil
+	}
+	if err := baseContext.Err(); err != nil {
+		return fmt.Errorf("%w: %w", ErrBaseContextDone, err)
+	}
+	factory.baseContextSyncOnce.Do(func() {
+		lifetimeContext, cancel := context.WithCancel(baseContext)
+		factory.baseContextCancel = cancel
+		generation := factory.baseContextGeneration
+		go func() {
+			<-lifetimeContext.Done()
+			if baseContext.Err() == nil {
+				return
+			}
+			factory.modeMutex.Lock()
+			defer factory.modeMutex.Unlock()
+			if factory.baseContextGeneration != generation {
+				return
+			}
+			factory.getLogger().Log(2005, baseContext.Err())
+			factory.destroy(context.Background())
+		}()
+	})
+	return nil
+}
+
+// Stop the BaseContext watcher started by watchBaseContext, if any, and start a new generation.
+// Called with modeMutex write-locked.
+func (factory *SdkAbstractFactoryImpl) stopBaseContextWatch() {
+	factory.baseContextGeneration++
+	if factory.baseContextCancel != nil {
+		factory.baseContextCancel()
+		factory.baseContextCancel = nil
+	}
+	factory.baseContextSyncOnce = sync.Once{}
+}
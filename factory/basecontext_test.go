@@ -0,0 +1,115 @@
+package factory
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/connectivity"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestWithBaseContext(test *testing.T) {
+	ctx := context.TODO()
+	baseContext, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	testObject, err := New(WithGrpcAddress(startTestGrpcServer(test)), WithBaseContext(baseContext))
+	testError(test, ctx, err)
+	_, err = testObject.GetG2engine(ctx)
+	testError(test, ctx, err)
+	grpcConnection, err := testObject.GrpcConnection(ctx)
+	testError(test, ctx, err)
+	factory := testObject.(*SdkAbstractFactoryImpl)
+	cancel()
+	assert.Eventually(test, func() bool {
+		factory.modeMutex.RLock()
+		defer factory.modeMutex.RUnlock()
+		return factory.g2engineSingleton == nil
+	}, 5*time.Second, 10*time.Millisecond, "cancelling the base context did not destroy the G2engine")
+	assert.Equal(test, connectivity.Shutdown, grpcConnection.GetState())
+	_, err = testObject.GetG2engine(ctx)
+	assert.ErrorIs(test, err, ErrBaseContextDone)
+	assert.ErrorIs(test, err, context.Canceled)
+}
+
+func TestWithBaseContext_destroyed(test *testing.T) {
+	ctx := context.TODO()
+	baseContext, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	testObject, err := New(WithGrpcAddress(startTestGrpcServer(test)), WithBaseContext(baseContext))
+	testError(test, ctx, err)
+	_, err = testObject.GetG2engine(ctx)
+	testError(test, ctx, err)
+	testError(test, ctx, testObject.Destroy(ctx))
+
+	// A factory used again after Destroy is bound to the base context anew.
+	_, err = testObject.GetG2product(ctx)
+	testError(test, ctx, err)
+	grpcConnection, err := testObject.GrpcConnection(ctx)
+	testError(test, ctx, err)
+	assert.NotEqual(test, connectivity.Shutdown, grpcConnection.GetState())
+	cancel()
+	assert.Eventually(test, func() bool {
+		return grpcConnection.GetState() == connectivity.Shutdown
+	}, 5*time.Second, 10*time.Millisecond, "cancelling the base context did not close the gRPC connection")
+}
+
+func TestWithBaseContext_resetBeforeWatcher(test *testing.T) {
+	ctx := context.TODO()
+	baseContext, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	testObject, err := New(WithNullBackend(), WithBaseContext(baseContext))
+	testError(test, ctx, err)
+	factory := testObject.(*SdkAbstractFactoryImpl)
+	_, err = testObject.GetG2engine(ctx)
+	testError(test, ctx, err)
+
+	// The watcher wakes while Reset holds the lock, and the factory is rebound to a new base context.
+	factory.modeMutex.Lock()
+	cancel()
+	time.Sleep(50 * time.Millisecond)
+	testError(test, ctx, factory.resetObjects(ctx, false))
+	factory.BaseContext = context.Background()
+	factory.modeMutex.Unlock()
+
+	g2engine, err := testObject.GetG2engine(ctx)
+	testError(test, ctx, err)
+	time.Sleep(100 * time.Millisecond)
+	current, err := testObject.GetG2engine(ctx)
+	testError(test, ctx, err)
+	assert.Same(test, g2engine, current, "a watcher from before Reset destroyed the new G2engine")
+}
+
+func TestWithBaseContext_concurrentReset(test *testing.T) {
+	ctx := context.TODO()
+	for i := 0; i < 50; i++ {
+		baseContext, cancel := context.WithCancel(context.Background())
+		testObject, err := New(WithNullBackend(), WithBaseContext(baseContext))
+		testError(test, ctx, err)
+		_, err = testObject.GetG2engine(ctx)
+		testError(test, ctx, err)
+		var waitGroup sync.WaitGroup
+		waitGroup.Add(2)
+		go func() {
+			defer waitGroup.Done()
+			cancel()
+		}()
+		go func() {
+			defer waitGroup.Done()
+			assert.NoError(test, testObject.Reset(ctx, true))
+		}()
+		waitGroup.Wait()
+		_, err = testObject.GetG2engine(ctx)
+		assert.ErrorIs(test, err, ErrBaseContextDone)
+	}
+}
+
+func TestWithBaseContext_nil(test *testing.T) {
+	_, err := New(WithBaseContext(nil))
+	assert.Error(test, err)
+}
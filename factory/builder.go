@@ -0,0 +1,69 @@
+package factory
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Builder is a chainable alternative to passing functional options to New.
+For example:
+
+	sdkAbstractFactory, err := factory.NewBuilder().
+		GrpcAddress("localhost:8258").
+		ModuleName("my-module").
+		Build()
+
+A Builder may be reused; every call to Build produces an independent factory.
+*/
+type Builder struct {
+	options []Option
+}
+
+// ----------------------------------------------------------------------------
+// Constructors
+// ----------------------------------------------------------------------------
+
+// NewBuilder returns an empty Builder.
+func NewBuilder() *Builder {
+	return &Builder{}
+}
+
+// ----------------------------------------------------------------------------
+// Builder methods
+// ----------------------------------------------------------------------------
+
+// Build validates the accumulated settings and constructs a factory, exactly as New does.
+func (builder *Builder) Build() (ManagedSdkAbstractFactory, error) {
+	return New(builder.options...)
+}
+
+// EngineConfigurationJson is the chainable form of WithEngineConfigurationJson.
+func (builder *Builder) EngineConfigurationJson(engineConfigurationJson string) *Builder {
+	return builder.With(WithEngineConfigurationJson(engineConfigurationJson))
+}
+
+// GrpcAddress is the chainable form of WithGrpcAddress.
+func (builder *Builder) GrpcAddress(grpcAddress string) *Builder {
+	return builder.With(WithGrpcAddress(grpcAddress))
+}
+
+// ModuleName is the chainable form of WithModuleName.
+func (builder *Builder) ModuleName(moduleName string) *Builder {
+	return builder.With(WithModuleName(moduleName))
+}
+
+// NullBackend is the chainable form of WithNullBackend.
+func (builder *Builder) NullBackend() *Builder {
+	return builder.With(WithNullBackend())
+}
+
+// VerboseLogging is the chainable form of WithVerboseLogging.
+func (builder *Builder) VerboseLogging(verboseLogging int) *Builder {
+	return builder.With(WithVerboseLogging(verboseLogging))
+}
+
+// With adds functional options for settings that have no dedicated Builder method.
+func (builder *Builder) With(options ...Option) *Builder {
+	builder.options = append(builder.options, options...)
+	return builder
+}
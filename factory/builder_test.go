@@ -0,0 +1,55 @@
+package factory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestBuilder_Build(test *testing.T) {
+	ctx := context.TODO()
+	testObject, err := NewBuilder().
+		GrpcAddress("localhost:8258").
+		ModuleName("test-module").
+		VerboseLogging(1).
+		With(WithGrpcDisableServiceConfig()).
+		Build()
+	testError(test, ctx, err)
+	factory := testObject.(*SdkAbstractFactoryImpl)
+	assert.Equal(test, "localhost:8258", factory.GrpcAddress)
+	assert.Equal(test, "test-module", factory.ModuleName)
+	assert.Equal(test, 1, factory.VerboseLogging)
+	assert.True(test, factory.GrpcDisableServiceConfig)
+	assert.Equal(test, ModeGrpc, testObject.Mode())
+}
+
+func TestBuilder_Build_conflictingBackends(test *testing.T) {
+	_, err := NewBuilder().
+		GrpcAddress("localhost:8258").
+		EngineConfigurationJson(`{"PIPELINE": {}}`).
+		Build()
+	assert.ErrorIs(test, err, ErrConflictingConfiguration)
+}
+
+func TestBuilder_Build_reuse(test *testing.T) {
+	ctx := context.TODO()
+	builder := NewBuilder().NullBackend().ModuleName("test-module")
+	testObject1, err := builder.Build()
+	testError(test, ctx, err)
+	testObject2, err := builder.Build()
+	testError(test, ctx, err)
+	assert.NotSame(test, testObject1, testObject2)
+
+	g2engine1, err := testObject1.GetG2engine(ctx)
+	testError(test, ctx, err)
+	_, created := testObject2.(*SdkAbstractFactoryImpl).G2engine()
+	assert.False(test, created, "objects created by one factory leaked into another")
+	g2engine2, err := testObject2.GetG2engine(ctx)
+	testError(test, ctx, err)
+	assert.NotSame(test, g2engine1, g2engine2)
+}
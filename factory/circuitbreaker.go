@@ -0,0 +1,130 @@
+package factory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+CircuitBreakerSettings configures the circuit breaker installed on gRPC connections.
+
+After FailureThreshold consecutive failed RPCs the circuit opens and calls fail
+immediately with ErrCircuitOpen.  Once Cooldown has elapsed a single probe call is
+let through; if it succeeds the circuit closes, otherwise it opens again.
+
+Only failures that indicate the server is unreachable or overloaded
+(codes.Unavailable and codes.DeadlineExceeded) are counted; Senzing errors
+returned by a healthy server are not.
+*/
+type CircuitBreakerSettings struct {
+	Cooldown         time.Duration
+	FailureThreshold int
+}
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+// circuitBreaker tracks consecutive failures shared by every RPC on a factory.
+type circuitBreaker struct {
+	consecutiveFailures int
+	lock                sync.Mutex
+	now                 func() time.Time
+	openedAt            time.Time
+	settings            CircuitBreakerSettings
+	state               circuitState
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func newCircuitBreaker(settings CircuitBreakerSettings) *circuitBreaker {
+	return &circuitBreaker{
+		now:      time.Now,
+		settings: settings,
+	}
+}
+
+// Determine if an RPC error indicates an unhealthy server.
+func isCircuitFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Decide whether a call may proceed.
+func (breaker *circuitBreaker) allow() bool {
+	breaker.lock.Lock()
+	defer breaker.lock.Unlock()
+	switch breaker.state {
+	case circuitOpen:
+		if breaker.now().Sub(breaker.openedAt) < breaker.settings.Cooldown {
+			return false
+		}
+		breaker.state = circuitHalfOpen
+		return true
+	case circuitHalfOpen:
+		return false
+	default:
+		return true
+	}
+}
+
+// Record the outcome of a call that was allowed.
+func (breaker *circuitBreaker) record(err error) {
+	breaker.lock.Lock()
+	defer breaker.lock.Unlock()
+	if !isCircuitFailure(err) {
+		breaker.consecutiveFailures = 0
+		breaker.state = circuitClosed
+		return
+	}
+	breaker.consecutiveFailures++
+	if breaker.state == circuitHalfOpen || breaker.consecutiveFailures >= breaker.settings.FailureThreshold {
+		breaker.state = circuitOpen
+		breaker.openedAt = breaker.now()
+	}
+}
+
+func (breaker *circuitBreaker) unaryInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if !breaker.allow() {
+			return ErrCircuitOpen
+		}
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		breaker.record(err)
+		return err
+	}
+}
+
+func (breaker *circuitBreaker) streamInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if !breaker.allow() {
+			return nil, ErrCircuitOpen
+		}
+		result, err := streamer(ctx, desc, cc, method, opts...)
+		breaker.record(err)
+		return result, err
+	}
+}
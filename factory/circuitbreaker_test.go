@@ -0,0 +1,56 @@
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestCircuitBreaker_unaryInterceptor(test *testing.T) {
+	ctx := context.TODO()
+	now := time.Now()
+	breaker := newCircuitBreaker(CircuitBreakerSettings{
+		Cooldown:         time.Minute,
+		FailureThreshold: 2,
+	})
+	breaker.now = func() time.Time { return now }
+	var invokeCount int
+	var invokeErr error
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		invokeCount++
+		return invokeErr
+	}
+	interceptor := breaker.unaryInterceptor()
+
+	// Consecutive failures open the circuit.
+
+	invokeErr = status.Error(codes.Unavailable, "connection refused")
+	assert.Error(test, interceptor(ctx, "/g2.G2Engine/Stats", nil, nil, nil, invoker))
+	assert.Error(test, interceptor(ctx, "/g2.G2Engine/Stats", nil, nil, nil, invoker))
+	assert.ErrorIs(test, interceptor(ctx, "/g2.G2Engine/Stats", nil, nil, nil, invoker), ErrCircuitOpen)
+	assert.Equal(test, 2, invokeCount)
+
+	// After the cooldown, a successful probe closes the circuit.
+
+	now = now.Add(time.Minute)
+	invokeErr = nil
+	assert.NoError(test, interceptor(ctx, "/g2.G2Engine/Stats", nil, nil, nil, invoker))
+	assert.NoError(test, interceptor(ctx, "/g2.G2Engine/Stats", nil, nil, nil, invoker))
+	assert.Equal(test, 4, invokeCount)
+}
+
+func TestCircuitBreaker_applicationErrorsDoNotTrip(test *testing.T) {
+	breaker := newCircuitBreaker(CircuitBreakerSettings{FailureThreshold: 1})
+	assert.True(test, breaker.allow())
+	breaker.record(status.Error(codes.Unknown, "senzing-60044001"))
+	assert.True(test, breaker.allow())
+}
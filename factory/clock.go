@@ -0,0 +1,45 @@
+package factory
+
+import (
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Clock is the source of time for the factory's caching, retry backoff, circuit breaker,
+// and graceful shutdown, so that tests can control it.  See WithClock.
+type Clock interface {
+	// After returns a channel that receives the current time once d has elapsed.
+	After(d time.Duration) <-chan time.Time
+	// Now returns the current time.
+	Now() time.Time
+}
+
+// realClock is the Clock used when SdkAbstractFactoryImpl.Clock is nil.
+type realClock struct{}
+
+// ----------------------------------------------------------------------------
+// Interface methods
+// ----------------------------------------------------------------------------
+
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Return Clock, or the real clock when it is nil.
+func (factory *SdkAbstractFactoryImpl) getClock() Clock {
+	if factory.Clock == nil {
+		return realClock{}
+	}
+	return factory.Clock
+}
@@ -0,0 +1,146 @@
+package factory
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+// ----------------------------------------------------------------------------
+// Test doubles
+// ----------------------------------------------------------------------------
+
+// fakeClock is a Clock whose time only moves when Advance is called.
+type fakeClock struct {
+	mutex   sync.Mutex
+	now     time.Time
+	waiters []fakeClockWaiter
+}
+
+type fakeClockWaiter struct {
+	channel  chan time.Time
+	deadline time.Time
+	duration time.Duration
+}
+
+func newFakeClock(now time.Time) *fakeClock {
+	return &fakeClock{now: now}
+}
+
+func (clock *fakeClock) After(d time.Duration) <-chan time.Time {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+	channel := make(chan time.Time, 1)
+	clock.waiters = append(clock.waiters, fakeClockWaiter{channel: channel, deadline: clock.now.Add(d), duration: d})
+	return channel
+}
+
+func (clock *fakeClock) Now() time.Time {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+	return clock.now
+}
+
+// Move the time forward by d, firing the After channels whose deadlines have passed.
+func (clock *fakeClock) Advance(d time.Duration) {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+	clock.now = clock.now.Add(d)
+	waiting := []fakeClockWaiter{}
+	for _, waiter := range clock.waiters {
+		if clock.now.Before(waiter.deadline) {
+			waiting = append(waiting, waiter)
+		} else {
+			waiter.channel <- clock.now
+		}
+	}
+	clock.waiters = waiting
+}
+
+// Wait until a caller blocks in After, and return the duration it waits for.
+func (clock *fakeClock) awaitWaiter(test *testing.T) time.Duration {
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		clock.mutex.Lock()
+		if len(clock.waiters) > 0 {
+			duration := clock.waiters[len(clock.waiters)-1].duration
+			clock.mutex.Unlock()
+			return duration
+		}
+		clock.mutex.Unlock()
+		time.Sleep(time.Millisecond)
+	}
+	test.Fatal("nothing is waiting on the fake clock")
+	return 0
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSdkAbstractFactoryImpl_License_fakeClock(test *testing.T) {
+	ctx := context.TODO()
+	clock := newFakeClock(time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC))
+	g2product := &licenseG2product{
+		license: `{"customer":"Senzing Public Test License","expireDate":"2024-03-01","recordLimit":50000}`,
+	}
+	testObject := getTestObjectWithG2product(g2product)
+	testError(test, ctx, WithClock(clock)(testObject))
+
+	actual, err := testObject.License(ctx)
+	testError(test, ctx, err)
+	assert.Equal(test, "Senzing Public Test License", actual.Customer)
+	assert.False(test, actual.Expired, "the license is valid through its expiration date")
+
+	g2product.license = `{"customer":"Renewed","expireDate":"2024-03-01"}`
+	clock.Advance(59 * time.Minute)
+	actual, err = testObject.License(ctx)
+	testError(test, ctx, err)
+	assert.Equal(test, "Senzing Public Test License", actual.Customer, "the license must come from the cache")
+
+	clock.Advance(time.Minute)
+	actual, err = testObject.License(ctx)
+	testError(test, ctx, err)
+	assert.Equal(test, "Renewed", actual.Customer, "the cache must expire after an hour")
+
+	clock.Advance(11 * time.Hour)
+	actual, err = testObject.License(ctx)
+	assert.ErrorIs(test, err, ErrLicenseExpired)
+	assert.True(test, actual.Expired)
+}
+
+func TestSdkAbstractFactoryImpl_getGrpcConnection_fakeClock(test *testing.T) {
+	ctx := context.TODO()
+	clock := newFakeClock(time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC))
+	var attempts int32
+	dialer := func(ctx context.Context) (*grpc.ClientConn, error) {
+		atomic.AddInt32(&attempts, 1)
+		return nil, errors.New("connection refused")
+	}
+	testObject, err := New(WithGrpcDialer(dialer), WithRetry(3, time.Hour), WithClock(clock))
+	testError(test, ctx, err)
+	result := make(chan error, 1)
+	go func() {
+		_, err := testObject.GetG2engine(ctx)
+		result <- err
+	}()
+	wait := clock.awaitWaiter(test)
+	assert.InDelta(test, float64(54*time.Minute), float64(wait), float64(6*time.Minute), "the first wait is the backoff, less up to 20%")
+	clock.Advance(wait)
+	wait = clock.awaitWaiter(test)
+	assert.InDelta(test, float64(108*time.Minute), float64(wait), float64(12*time.Minute), "the backoff must double")
+	clock.Advance(wait)
+	assert.ErrorIs(test, <-result, ErrGrpcDial)
+	assert.Equal(test, int32(3), atomic.LoadInt32(&attempts))
+}
+
+func TestWithClock_nil(test *testing.T) {
+	assert.Error(test, WithClock(nil)(&SdkAbstractFactoryImpl{}))
+	assert.IsType(test, realClock{}, (&SdkAbstractFactoryImpl{}).getClock())
+}
@@ -0,0 +1,132 @@
+package factory
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Copy a map so changing the copy cannot modify the original.  A nil map stays nil.
+func cloneMap[K comparable, V any](aMap map[K]V) map[K]V {
+	if aMap == nil {
+		return nil
+	}
+	result := make(map[K]V, len(aMap))
+	for key, value := range aMap {
+		result[key] = value
+	}
+	return result
+}
+
+// Copy a slice so appending to the copy cannot modify the original.  A nil slice stays nil,
+// which matters for GrpcOptions: nil selects the default insecure transport credentials.
+func cloneSlice[T any](aSlice []T) []T {
+	if aSlice == nil {
+		return nil
+	}
+	return append(make([]T, 0, len(aSlice)), aSlice...)
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+/*
+The Clone method returns a new factory with the same configuration, modified by options.
+Only configuration is copied: the clone has no Senzing objects, gRPC connection, or
+observers of its own yet, so its GetG2* methods build objects independent of the original's.
+A GrpcSharedConnection is shared by both factories and remains owned by the caller.
+
+Input
+  - options: Functional options, such as WithModuleName or WithGrpcAddress, applied to the copy.
+
+Output
+  - The new ManagedSdkAbstractFactory, validated as by New, and initialized as by New if EagerInitialization is set.
+*/
+func (factory *SdkAbstractFactoryImpl) Clone(options ...Option) (ManagedSdkAbstractFactory, error) {
+	result := &SdkAbstractFactoryImpl{
+		BaseContext:                 factory.BaseContext,
+		Clock:                       factory.Clock,
+		CreationBackoff:             factory.CreationBackoff,
+		DefaultConfigJson:           factory.DefaultConfigJson,
+		DefaultEngineFlags:          factory.DefaultEngineFlags,
+		EagerInitialization:         factory.EagerInitialization,
+		EngineConfigurationJson:     factory.EngineConfigurationJson,
+		Environment:                 factory.Environment,
+		GracefulShutdownTimeout:     factory.GracefulShutdownTimeout,
+		GrpcAddress:                 factory.GrpcAddress,
+		GrpcAuthority:               factory.GrpcAuthority,
+		GrpcCompressor:              factory.GrpcCompressor,
+		GrpcConnectionStateCallback: factory.GrpcConnectionStateCallback,
+		GrpcDialer:                  factory.GrpcDialer,
+		grpcDialOptions:             cloneSlice(factory.grpcDialOptions),
+		GrpcDialOptionsFromEnv:      factory.GrpcDialOptionsFromEnv,
+		GrpcDisableServiceConfig:    factory.GrpcDisableServiceConfig,
+		GrpcLoadBalancingPolicy:     factory.GrpcLoadBalancingPolicy,
+		GrpcMaxRecvMsgSize:          factory.GrpcMaxRecvMsgSize,
+		GrpcMaxSendMsgSize:          factory.GrpcMaxSendMsgSize,
+		GrpcOptions:                 cloneSlice(factory.GrpcOptions),
+		GrpcProxy:                   factory.GrpcProxy,
+		GrpcRetryAttempts:           factory.GrpcRetryAttempts,
+		GrpcRetryBackoff:            factory.GrpcRetryBackoff,
+		GrpcSharedConnection:        factory.GrpcSharedConnection,
+		GrpcStatsHandlers:           cloneSlice(factory.GrpcStatsHandlers),
+		GrpcStreamInterceptors:      cloneSlice(factory.GrpcStreamInterceptors),
+		GrpcTLSFiles:                cloneSlice(factory.GrpcTLSFiles),
+		GrpcTransportCredentials:    factory.GrpcTransportCredentials,
+		GrpcUnaryInterceptors:       cloneSlice(factory.GrpcUnaryInterceptors),
+		GrpcWaitForReady:            factory.GrpcWaitForReady,
+		LocalBackend:                factory.LocalBackend,
+		Metrics:                     factory.Metrics,
+		ModuleName:                  factory.ModuleName,
+		ModuleNameTemplate:          factory.ModuleNameTemplate,
+		NullBackend:                 factory.NullBackend,
+		ObserverBufferSize:          factory.ObserverBufferSize,
+		OnUnauthenticated:           factory.OnUnauthenticated,
+		OperationTimeouts:           cloneMap(factory.OperationTimeouts),
+		OtelMeterProvider:           factory.OtelMeterProvider,
+		PurgeOnInit:                 factory.PurgeOnInit,
+		PurgeOnInitConfirmed:        factory.PurgeOnInitConfirmed,
+		SharedNativeInit:            factory.SharedNativeInit,
+		StrictEager:                 factory.StrictEager,
+		StrictLicense:               factory.StrictLicense,
+		VerboseLogging:              factory.VerboseLogging,
+	}
+	if factory.CircuitBreaker != nil {
+		circuitBreakerSettings := *factory.CircuitBreaker
+		result.CircuitBreaker = &circuitBreakerSettings
+	}
+	if factory.DBPerfGate != nil {
+		dbPerfGateSettings := *factory.DBPerfGate
+		result.DBPerfGate = &dbPerfGateSettings
+	}
+	if factory.GrpcMetadata != nil {
+		result.GrpcMetadata = factory.GrpcMetadata.Copy()
+	}
+	if factory.GrpcDialTimeout != nil {
+		grpcDialTimeout := *factory.GrpcDialTimeout
+		result.GrpcDialTimeout = &grpcDialTimeout
+	}
+	if factory.GrpcConnectBackoff != nil {
+		grpcConnectBackoff := *factory.GrpcConnectBackoff
+		result.GrpcConnectBackoff = &grpcConnectBackoff
+	}
+	if factory.GrpcKeepalive != nil {
+		grpcKeepalive := *factory.GrpcKeepalive
+		result.GrpcKeepalive = &grpcKeepalive
+	}
+	if factory.LicenseCacheTTL != nil {
+		licenseCacheTTL := *factory.LicenseCacheTTL
+		result.LicenseCacheTTL = &licenseCacheTTL
+	}
+	for _, option := range options {
+		if err := option(result); err != nil {
+			return nil, err
+		}
+	}
+	if err := result.validate(); err != nil {
+		return nil, err
+	}
+	if err := result.initializeIfEager(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
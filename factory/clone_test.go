@@ -0,0 +1,77 @@
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSdkAbstractFactoryImpl_Clone(test *testing.T) {
+	ctx := context.TODO()
+	original := &SdkAbstractFactoryImpl{
+		GrpcAddress:  "localhost:8258",
+		GrpcMetadata: metadata.Pairs("x-api-version", "1"),
+		ModuleName:   moduleName,
+	}
+	defer original.Destroy(ctx)
+	result, err := original.Clone(WithModuleName("Clone module name"))
+	testError(test, ctx, err)
+	clone := result.(*SdkAbstractFactoryImpl)
+	defer clone.Destroy(ctx)
+	assert.Equal(test, "localhost:8258", clone.GrpcAddress)
+	assert.Equal(test, "Clone module name", clone.ModuleName)
+	assert.Equal(test, moduleName, original.ModuleName)
+
+	originalG2engine, err := original.GetG2engine(ctx)
+	testError(test, ctx, err)
+	cloneG2engine, err := clone.GetG2engine(ctx)
+	testError(test, ctx, err)
+	assert.NotSame(test, originalG2engine, cloneG2engine)
+	assert.NotSame(test, original.grpcConnection, clone.grpcConnection)
+}
+
+func TestSdkAbstractFactoryImpl_Clone_independentConfiguration(test *testing.T) {
+	ctx := context.TODO()
+	original := &SdkAbstractFactoryImpl{
+		GrpcAddress:           "localhost:8258",
+		GrpcMetadata:          metadata.Pairs("x-api-version", "1"),
+		GrpcUnaryInterceptors: []grpc.UnaryClientInterceptor{connectionMetadataUnaryInterceptor(nil)},
+		OperationTimeouts:     map[OperationKind]time.Duration{OperationRead: time.Second},
+	}
+	result, err := original.Clone(WithUnaryInterceptor(connectionMetadataUnaryInterceptor(nil)), WithOperationTimeout(OperationRead, time.Minute))
+	testError(test, ctx, err)
+	clone := result.(*SdkAbstractFactoryImpl)
+	assert.Equal(test, time.Second, original.OperationTimeouts[OperationRead])
+	clone.GrpcMetadata.Set("x-api-version", "2")
+	assert.Len(test, original.GrpcUnaryInterceptors, 1)
+	assert.Len(test, clone.GrpcUnaryInterceptors, 2)
+	assert.Equal(test, []string{"1"}, original.GrpcMetadata.Get("x-api-version"))
+	assert.Nil(test, clone.GrpcOptions, "nil GrpcOptions keeps the default transport credentials")
+}
+
+func TestSdkAbstractFactoryImpl_Clone_invalid(test *testing.T) {
+	original := &SdkAbstractFactoryImpl{
+		GrpcAddress: "localhost:8258",
+	}
+	_, err := original.Clone(WithEngineConfigurationJson(iniParams))
+	assert.ErrorIs(test, err, ErrConflictingConfiguration)
+}
+
+func TestSdkAbstractFactoryImpl_Clone_eagerInitialization(test *testing.T) {
+	ctx := context.TODO()
+	original, err := New(WithNullBackend(), WithEagerInitialization())
+	testError(test, ctx, err)
+	defer original.Destroy(ctx)
+	clone, err := original.(*SdkAbstractFactoryImpl).Clone(WithModuleName("Clone module name"))
+	testError(test, ctx, err)
+	defer clone.Destroy(ctx)
+	assert.Len(test, clone.CreatedObjects(ctx), 5)
+}
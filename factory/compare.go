@@ -0,0 +1,129 @@
+package factory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// SdkAbstractFactoryComparator runs the same request against two factories and diffs the results.
+// It supports dual-run validation, e.g. a local Baseline against a gRPC Candidate.
+type SdkAbstractFactoryComparator struct {
+	Baseline  SdkAbstractFactory
+	Candidate SdkAbstractFactory
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Describe the differences between two decoded JSON values, one line per differing path.
+func diffJson(path string, baseline interface{}, candidate interface{}, differences []string) []string {
+	baselineMap, baselineIsMap := baseline.(map[string]interface{})
+	candidateMap, candidateIsMap := candidate.(map[string]interface{})
+	if baselineIsMap && candidateIsMap {
+		keys := map[string]bool{}
+		for key := range baselineMap {
+			keys[key] = true
+		}
+		for key := range candidateMap {
+			keys[key] = true
+		}
+		sortedKeys := make([]string, 0, len(keys))
+		for key := range keys {
+			sortedKeys = append(sortedKeys, key)
+		}
+		sort.Strings(sortedKeys)
+		for _, key := range sortedKeys {
+			differences = diffJson(joinJsonPath(path, key), baselineMap[key], candidateMap[key], differences)
+		}
+		return differences
+	}
+	baselineSlice, baselineIsSlice := baseline.([]interface{})
+	candidateSlice, candidateIsSlice := candidate.([]interface{})
+	if baselineIsSlice && candidateIsSlice && len(baselineSlice) == len(candidateSlice) {
+		for index := range baselineSlice {
+			differences = diffJson(fmt.Sprintf("%s[%d]", path, index), baselineSlice[index], candidateSlice[index], differences)
+		}
+		return differences
+	}
+	if !reflect.DeepEqual(baseline, candidate) {
+		differences = append(differences, fmt.Sprintf("%s: %s != %s", path, marshalForDiff(baseline), marshalForDiff(candidate)))
+	}
+	return differences
+}
+
+func joinJsonPath(path string, key string) string {
+	if len(path) == 0 {
+		return key
+	}
+	return path + "." + key
+}
+
+func marshalForDiff(value interface{}) string {
+	if value == nil {
+		return "<missing>"
+	}
+	result, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(result)
+}
+
+// Compare two JSON documents semantically, ignoring key order and whitespace.
+func compareJson(baseline string, candidate string) (bool, string, error) {
+	var baselineValue interface{}
+	var candidateValue interface{}
+	if err := json.Unmarshal([]byte(baseline), &baselineValue); err != nil {
+		return false, "", fmt.Errorf("cannot parse baseline JSON: %w", err)
+	}
+	if err := json.Unmarshal([]byte(candidate), &candidateValue); err != nil {
+		return false, "", fmt.Errorf("cannot parse candidate JSON: %w", err)
+	}
+	differences := diffJson("", baselineValue, candidateValue, []string{})
+	return len(differences) == 0, strings.Join(differences, "\n"), nil
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+/*
+The CompareGetEntityByEntityID method calls G2engine.GetEntityByEntityID on both
+the Baseline and Candidate factories and compares the normalized JSON results.
+
+Input
+  - ctx: A context to control lifecycle.
+  - entityID: The unique identifier of an entity.
+
+Output
+  - true if both backends returned equivalent JSON.
+  - A description of the differences, one "path: baseline != candidate" line per difference.
+*/
+func (comparator *SdkAbstractFactoryComparator) CompareGetEntityByEntityID(ctx context.Context, entityID int64) (bool, string, error) {
+	baselineEngine, err := comparator.Baseline.GetG2engine(ctx)
+	if err != nil {
+		return false, "", err
+	}
+	candidateEngine, err := comparator.Candidate.GetG2engine(ctx)
+	if err != nil {
+		return false, "", err
+	}
+	baseline, err := baselineEngine.GetEntityByEntityID(ctx, entityID)
+	if err != nil {
+		return false, "", err
+	}
+	candidate, err := candidateEngine.GetEntityByEntityID(ctx, entityID)
+	if err != nil {
+		return false, "", err
+	}
+	return compareJson(baseline, candidate)
+}
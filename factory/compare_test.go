@@ -0,0 +1,50 @@
+package factory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/senzing/g2-sdk-go/g2api"
+	"github.com/stretchr/testify/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Test doubles
+// ----------------------------------------------------------------------------
+
+type entityG2engine struct {
+	g2api.G2engine
+	entity string
+}
+
+func (g2engine *entityG2engine) GetEntityByEntityID(ctx context.Context, entityID int64) (string, error) {
+	return g2engine.entity, nil
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSdkAbstractFactoryComparator_CompareGetEntityByEntityID_match(test *testing.T) {
+	ctx := context.TODO()
+	testObject := &SdkAbstractFactoryComparator{
+		Baseline:  getTestObjectWithG2engine(&entityG2engine{entity: `{"RESOLVED_ENTITY": {"ENTITY_ID": 1, "ENTITY_NAME": "SEAMAN"}}`}),
+		Candidate: getTestObjectWithG2engine(&entityG2engine{entity: `{"RESOLVED_ENTITY":{"ENTITY_NAME":"SEAMAN","ENTITY_ID":1}}`}),
+	}
+	matched, diff, err := testObject.CompareGetEntityByEntityID(ctx, 1)
+	testError(test, ctx, err)
+	assert.True(test, matched)
+	assert.Empty(test, diff)
+}
+
+func TestSdkAbstractFactoryComparator_CompareGetEntityByEntityID_mismatch(test *testing.T) {
+	ctx := context.TODO()
+	testObject := &SdkAbstractFactoryComparator{
+		Baseline:  getTestObjectWithG2engine(&entityG2engine{entity: `{"RESOLVED_ENTITY": {"ENTITY_ID": 1, "ENTITY_NAME": "SEAMAN"}}`}),
+		Candidate: getTestObjectWithG2engine(&entityG2engine{entity: `{"RESOLVED_ENTITY": {"ENTITY_ID": 1, "ENTITY_NAME": "SEEMAN"}}`}),
+	}
+	matched, diff, err := testObject.CompareGetEntityByEntityID(ctx, 1)
+	testError(test, ctx, err)
+	assert.False(test, matched)
+	assert.Equal(test, `RESOLVED_ENTITY.ENTITY_NAME: "SEAMAN" != "SEEMAN"`, diff)
+}
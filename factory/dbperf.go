@@ -0,0 +1,60 @@
+package factory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/senzing/g2-sdk-go/g2api"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+DBPerfGateSettings configures the database performance check made by Initialize.
+
+G2diagnostic.CheckDBPerf inserts test records for Duration, which is rounded down to whole
+seconds; Initialize fails with ErrDBPerformance if fewer than MinInsertsPerSec were inserted
+per second.
+*/
+type DBPerfGateSettings struct {
+	Duration         time.Duration
+	MinInsertsPerSec int
+}
+
+// The result of G2diagnostic.CheckDBPerf, e.g. {"numRecordsInserted":76636,"insertTime":1000}.
+type dbPerfResult struct {
+	InsertTime         int64 `json:"insertTime"` // Milliseconds.
+	NumRecordsInserted int64 `json:"numRecordsInserted"`
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Run G2diagnostic.CheckDBPerf if DBPerfGate is set, and fail if the insert rate is below its minimum.
+// Null factories have no database, so they are not checked.  Called by Initialize once all objects exist.
+func (factory *SdkAbstractFactoryImpl) checkDBPerfGate(ctx context.Context, g2diagnostic g2api.G2diagnostic) error {
+	if factory.DBPerfGate == nil || factory.Mode() == ModeNull {
+		return nil
+	}
+	response, err := g2diagnostic.CheckDBPerf(ctx, int(factory.DBPerfGate.Duration/time.Second))
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrDBPerformance, err)
+	}
+	var result dbPerfResult
+	if err := json.Unmarshal([]byte(response), &result); err != nil {
+		return fmt.Errorf("%w: cannot parse CheckDBPerf result %q: %w", ErrDBPerformance, response, err)
+	}
+	insertsPerSec := int64(0)
+	if result.InsertTime > 0 {
+		insertsPerSec = result.NumRecordsInserted * 1000 / result.InsertTime
+	}
+	if insertsPerSec < int64(factory.DBPerfGate.MinInsertsPerSec) {
+		return fmt.Errorf("%w: %d inserts per second, fewer than the minimum of %d", ErrDBPerformance, insertsPerSec, factory.DBPerfGate.MinInsertsPerSec)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package factory
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/senzing/g2-sdk-go/g2api"
+	"github.com/stretchr/testify/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Test doubles
+// ----------------------------------------------------------------------------
+
+type dbPerfG2diagnostic struct {
+	g2api.G2diagnostic
+	err          error
+	response     string
+	secondsToRun int
+}
+
+func (g2diagnostic *dbPerfG2diagnostic) CheckDBPerf(ctx context.Context, secondsToRun int) (string, error) {
+	g2diagnostic.secondsToRun = secondsToRun
+	return g2diagnostic.response, g2diagnostic.err
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestWithDBPerfGate(test *testing.T) {
+	ctx := context.TODO()
+	g2diagnostic := &dbPerfG2diagnostic{response: `{"numRecordsInserted":5000,"insertTime":2000}`}
+	testObject := getTestObjectForHealthCheck(g2diagnostic)
+	testError(test, ctx, WithDBPerfGate(2500, 2500*time.Millisecond)(testObject))
+	testError(test, ctx, testObject.Initialize(ctx))
+	assert.Equal(test, 2, g2diagnostic.secondsToRun)
+}
+
+func TestWithDBPerfGate_slow(test *testing.T) {
+	ctx := context.TODO()
+	g2diagnostic := &dbPerfG2diagnostic{response: `{"numRecordsInserted":500,"insertTime":1000}`}
+	testObject := getTestObjectForHealthCheck(g2diagnostic)
+	testError(test, ctx, WithDBPerfGate(1000, time.Second)(testObject))
+	err := testObject.Initialize(ctx)
+	assert.ErrorIs(test, err, ErrDBPerformance)
+	assert.ErrorContains(test, err, "500 inserts per second, fewer than the minimum of 1000")
+}
+
+func TestWithDBPerfGate_checkFails(test *testing.T) {
+	ctx := context.TODO()
+	checkErr := errors.New("database unavailable")
+	testObject := getTestObjectForHealthCheck(&dbPerfG2diagnostic{err: checkErr})
+	testError(test, ctx, WithDBPerfGate(1000, time.Second)(testObject))
+	err := testObject.Initialize(ctx)
+	assert.ErrorIs(test, err, ErrDBPerformance)
+	assert.ErrorIs(test, err, checkErr)
+
+	testObject = getTestObjectForHealthCheck(&dbPerfG2diagnostic{response: `not JSON`})
+	testError(test, ctx, WithDBPerfGate(1000, time.Second)(testObject))
+	assert.ErrorIs(test, testObject.Initialize(ctx), ErrDBPerformance)
+}
+
+func TestWithDBPerfGate_null(test *testing.T) {
+	ctx := context.TODO()
+	testObject, err := New(WithNullBackend(), WithDBPerfGate(1000, time.Second), WithEagerInitialization())
+	testError(test, ctx, err)
+	assert.Len(test, testObject.CreatedObjects(ctx), 5)
+}
+
+func TestWithDBPerfGate_invalid(test *testing.T) {
+	assert.Error(test, WithDBPerfGate(0, time.Second)(&SdkAbstractFactoryImpl{}))
+	assert.Error(test, WithDBPerfGate(1000, 500*time.Millisecond)(&SdkAbstractFactoryImpl{}))
+}
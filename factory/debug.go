@@ -0,0 +1,73 @@
+package factory
+
+import (
+	"context"
+	"fmt"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// DebugInfo gathers the factory's state for operator-facing debug endpoints.
+// Fields that could not be retrieved are left empty and the reason is recorded in Errors.
+type DebugInfo struct {
+	ActiveConfigID int64             `json:"activeConfigId,omitempty"`
+	CreatedObjects []string          `json:"createdObjects"`
+	EngineStats    string            `json:"engineStats,omitempty"`
+	Errors         map[string]string `json:"errors,omitempty"`
+	GrpcAddress    string            `json:"grpcAddress,omitempty"`
+	License        *LicenseInfo      `json:"license,omitempty"`
+	Mode           string            `json:"mode"`
+}
+
+// ----------------------------------------------------------------------------
+// Interface methods
+// ----------------------------------------------------------------------------
+
+/*
+The DebugInfo method collects the factory's configuration and the state of its
+Senzing objects into one serializable structure.
+Collection is best-effort: a failure retrieving one item is recorded in
+DebugInfo.Errors and does not prevent the others from being reported.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The collected debug information.
+*/
+func (factory *SdkAbstractFactoryImpl) DebugInfo(ctx context.Context) (DebugInfo, error) {
+	ctx = factory.getContext(ctx)
+	result := DebugInfo{
+		CreatedObjects: []string{},
+		Errors:         map[string]string{},
+		GrpcAddress:    factory.GrpcAddress,
+		Mode:           factory.Mode().String(),
+	}
+	for _, object := range factory.CreatedObjects(ctx) {
+		result.CreatedObjects = append(result.CreatedObjects, fmt.Sprintf("%T", object))
+	}
+
+	license, err := factory.LicenseInfo(ctx)
+	if err != nil {
+		result.Errors["license"] = err.Error()
+	} else {
+		result.License = &license
+	}
+
+	g2engine, err := factory.GetG2engine(ctx)
+	if err != nil {
+		result.Errors["g2engine"] = err.Error()
+		return result, nil
+	}
+	result.ActiveConfigID, err = g2engine.GetActiveConfigID(ctx)
+	if err != nil {
+		result.Errors["activeConfigId"] = err.Error()
+	}
+	result.EngineStats, err = g2engine.Stats(ctx)
+	if err != nil {
+		result.Errors["engineStats"] = err.Error()
+	}
+	return result, nil
+}
@@ -0,0 +1,46 @@
+package factory
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/senzing/g2-sdk-go/g2api"
+	"github.com/stretchr/testify/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Test doubles
+// ----------------------------------------------------------------------------
+
+type debugG2engine struct {
+	g2api.G2engine
+}
+
+func (g2engine *debugG2engine) GetActiveConfigID(ctx context.Context) (int64, error) {
+	return 1001, nil
+}
+
+func (g2engine *debugG2engine) Stats(ctx context.Context) (string, error) {
+	return "", errors.New("stats unavailable")
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSdkAbstractFactoryImpl_DebugInfo(test *testing.T) {
+	ctx := context.TODO()
+	testObject := getTestObjectWithG2engine(&debugG2engine{})
+	testObject.g2productSyncOnce.Do(func() error {
+		testObject.g2productSingleton = &licenseG2product{license: `{"customer":"Test","expireDate":"2023-11-29"}`}
+		return nil
+	})
+	actual, err := testObject.DebugInfo(ctx)
+	testError(test, ctx, err)
+	assert.Equal(test, "local", actual.Mode)
+	assert.Equal(test, int64(1001), actual.ActiveConfigID)
+	assert.Equal(test, "Test", actual.License.Customer)
+	assert.Len(test, actual.CreatedObjects, 2)
+	assert.Equal(test, map[string]string{"engineStats": "stats unavailable"}, actual.Errors)
+}
@@ -0,0 +1,89 @@
+package factory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/senzing/go-common/truthset"
+)
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Build a configuration containing the truth-set data sources, as main.go does.
+func (factory *SdkAbstractFactoryImpl) createDefaultConfig(ctx context.Context) (string, error) {
+	g2config, err := factory.GetG2config(ctx)
+	if err != nil {
+		return "", err
+	}
+	configHandle, err := g2config.Create(ctx)
+	if err != nil {
+		return "", fmt.Errorf("G2config.Create: %w", err)
+	}
+	defer g2config.Close(ctx, configHandle)
+	dataSourceCodes := make([]string, 0, len(truthset.TruthsetDataSources))
+	for dataSourceCode := range truthset.TruthsetDataSources {
+		dataSourceCodes = append(dataSourceCodes, dataSourceCode)
+	}
+	sort.Strings(dataSourceCodes)
+	for _, dataSourceCode := range dataSourceCodes {
+		_, err := g2config.AddDataSource(ctx, configHandle, truthset.TruthsetDataSources[dataSourceCode].Json)
+		if err != nil {
+			return "", fmt.Errorf("G2config.AddDataSource(%s): %w", dataSourceCode, err)
+		}
+	}
+	return g2config.Save(ctx, configHandle)
+}
+
+// ----------------------------------------------------------------------------
+// Interface methods
+// ----------------------------------------------------------------------------
+
+/*
+The EnsureDefaultConfig method persists a default Senzing configuration if the repository has none.
+G2engine needs a default configuration to initialize, so call EnsureDefaultConfig before GetG2engine.
+The configuration is the one set by WithDefaultConfig or, if none was set, one with the truth-set data sources.
+When a default configuration already exists, nothing is changed.
+
+Input
+  - ctx: A context to control lifecycle.
+  - configComments: The comments stored with a newly added configuration.
+
+Output
+  - nil if a default configuration exists or was created, or in ModeNull.
+*/
+func (factory *SdkAbstractFactoryImpl) EnsureDefaultConfig(ctx context.Context, configComments string) error {
+	ctx = factory.getContext(ctx)
+	if factory.NullBackend {
+		return nil
+	}
+	g2configmgr, err := factory.GetG2configmgr(ctx)
+	if err != nil {
+		return err
+	}
+	configID, err := g2configmgr.GetDefaultConfigID(ctx)
+	if err != nil {
+		return fmt.Errorf("G2configmgr.GetDefaultConfigID: %w", err)
+	}
+	if configID != 0 {
+		return nil
+	}
+	configJson := factory.DefaultConfigJson
+	if len(configJson) == 0 {
+		configJson, err = factory.createDefaultConfig(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	configID, err = g2configmgr.AddConfig(ctx, configJson, configComments)
+	if err != nil {
+		return fmt.Errorf("G2configmgr.AddConfig: %w", err)
+	}
+	err = g2configmgr.SetDefaultConfigID(ctx, configID)
+	if err != nil {
+		return fmt.Errorf("G2configmgr.SetDefaultConfigID: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package factory
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/senzing/g2-sdk-go/g2api"
+	"github.com/stretchr/testify/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Test doubles
+// ----------------------------------------------------------------------------
+
+type defaultConfigG2config struct {
+	g2api.G2config
+	closed bool
+	loaded string
+}
+
+func (g2config *defaultConfigG2config) AddDataSource(ctx context.Context, configHandle uintptr, inputJson string) (string, error) {
+	return "", nil
+}
+
+func (g2config *defaultConfigG2config) Close(ctx context.Context, configHandle uintptr) error {
+	g2config.closed = true
+	return nil
+}
+
+func (g2config *defaultConfigG2config) Create(ctx context.Context) (uintptr, error) {
+	return 1, nil
+}
+
+func (g2config *defaultConfigG2config) Load(ctx context.Context, configHandle uintptr, jsonConfig string) error {
+	g2config.loaded = jsonConfig
+	return nil
+}
+
+func (g2config *defaultConfigG2config) Save(ctx context.Context, configHandle uintptr) (string, error) {
+	return `{"G2_CONFIG": {}}`, nil
+}
+
+type defaultConfigG2configmgr struct {
+	g2api.G2configmgr
+	configs         map[int64]string
+	defaultConfigID int64
+}
+
+func (g2configmgr *defaultConfigG2configmgr) AddConfig(ctx context.Context, configStr string, configComments string) (int64, error) {
+	configID := int64(len(g2configmgr.configs) + 1)
+	g2configmgr.configs[configID] = configStr
+	return configID, nil
+}
+
+func (g2configmgr *defaultConfigG2configmgr) GetConfig(ctx context.Context, configID int64) (string, error) {
+	configStr, ok := g2configmgr.configs[configID]
+	if !ok {
+		return "", fmt.Errorf("no configuration %d", configID)
+	}
+	return configStr, nil
+}
+
+func (g2configmgr *defaultConfigG2configmgr) GetDefaultConfigID(ctx context.Context) (int64, error) {
+	return g2configmgr.defaultConfigID, nil
+}
+
+func (g2configmgr *defaultConfigG2configmgr) SetDefaultConfigID(ctx context.Context, configID int64) error {
+	g2configmgr.defaultConfigID = configID
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func getTestObjectForDefaultConfig(g2config g2api.G2config, g2configmgr g2api.G2configmgr, options ...Option) *SdkAbstractFactoryImpl {
+	result := &SdkAbstractFactoryImpl{}
+	for _, option := range options {
+		option(result)
+	}
+	result.g2configSyncOnce.Do(func() error {
+		result.g2configSingleton = g2config
+		return nil
+	})
+	result.g2configmgrSyncOnce.Do(func() error {
+		result.g2configmgrSingleton = g2configmgr
+		return nil
+	})
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSdkAbstractFactoryImpl_EnsureDefaultConfig(test *testing.T) {
+	ctx := context.TODO()
+	g2config := &defaultConfigG2config{}
+	g2configmgr := &defaultConfigG2configmgr{configs: map[int64]string{}}
+	testObject := getTestObjectForDefaultConfig(g2config, g2configmgr)
+	err := testObject.EnsureDefaultConfig(ctx, "Created by TestSdkAbstractFactoryImpl_EnsureDefaultConfig")
+	testError(test, ctx, err)
+	assert.NotZero(test, g2configmgr.defaultConfigID)
+	assert.Equal(test, `{"G2_CONFIG": {}}`, g2configmgr.configs[g2configmgr.defaultConfigID])
+	assert.True(test, g2config.closed, "the configuration handle was not closed")
+
+	// A second call finds the default and adds nothing.
+
+	err = testObject.EnsureDefaultConfig(ctx, "Created by TestSdkAbstractFactoryImpl_EnsureDefaultConfig")
+	testError(test, ctx, err)
+	assert.Len(test, g2configmgr.configs, 1)
+}
+
+func TestSdkAbstractFactoryImpl_EnsureDefaultConfig_existing(test *testing.T) {
+	ctx := context.TODO()
+	g2configmgr := &defaultConfigG2configmgr{configs: map[int64]string{}, defaultConfigID: 4015588140}
+	testObject := getTestObjectForDefaultConfig(&defaultConfigG2config{}, g2configmgr)
+	err := testObject.EnsureDefaultConfig(ctx, "Not stored")
+	testError(test, ctx, err)
+	assert.Equal(test, int64(4015588140), g2configmgr.defaultConfigID)
+	assert.Empty(test, g2configmgr.configs)
+}
+
+func TestSdkAbstractFactoryImpl_EnsureDefaultConfig_withDefaultConfig(test *testing.T) {
+	ctx := context.TODO()
+	g2config := &defaultConfigG2config{}
+	g2configmgr := &defaultConfigG2configmgr{configs: map[int64]string{}}
+	testObject := getTestObjectForDefaultConfig(g2config, g2configmgr, WithDefaultConfig(`{"G2_CONFIG": {"CFG_DSRC": []}}`))
+	err := testObject.EnsureDefaultConfig(ctx, "Supplied by the caller")
+	testError(test, ctx, err)
+	assert.Equal(test, `{"G2_CONFIG": {"CFG_DSRC": []}}`, g2configmgr.configs[g2configmgr.defaultConfigID])
+	assert.False(test, g2config.closed, "G2config was used although a configuration was supplied")
+}
+
+func TestSdkAbstractFactoryImpl_EnsureDefaultConfig_null(test *testing.T) {
+	ctx := context.TODO()
+	testObject, err := New(WithNullBackend())
+	testError(test, ctx, err)
+	err = testObject.EnsureDefaultConfig(ctx, "Not stored")
+	testError(test, ctx, err)
+}
+
+func TestSdkAbstractFactoryImpl_GetG2configWithLoad(test *testing.T) {
+	ctx := context.TODO()
+	g2config := &defaultConfigG2config{}
+	g2configmgr := &defaultConfigG2configmgr{configs: map[int64]string{}}
+	testObject := getTestObjectForDefaultConfig(g2config, g2configmgr)
+	configID, err := g2configmgr.AddConfig(ctx, `{"G2_CONFIG": {"CFG_DSRC": [{"DSRC_CODE": "LOADED_CONFIG"}]}}`, "Stored")
+	testError(test, ctx, err)
+	actual, configHandle, err := testObject.GetG2configWithLoad(ctx, configID)
+	testError(test, ctx, err)
+	assert.Same(test, g2config, actual)
+	assert.NotZero(test, configHandle)
+	assert.Equal(test, `{"G2_CONFIG": {"CFG_DSRC": [{"DSRC_CODE": "LOADED_CONFIG"}]}}`, g2config.loaded)
+
+	_, _, err = testObject.GetG2configWithLoad(ctx, configID+1)
+	assert.Error(test, err)
+}
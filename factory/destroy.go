@@ -0,0 +1,167 @@
+package factory
+
+import (
+	"context"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// destroyable is implemented by every G2* object.
+type destroyable interface {
+	observable
+	Destroy(ctx context.Context) error
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Destroy a single singleton, as Destroy does for all of them, and reset its successOnce
+// so the next GetG2* call creates it again.  The gRPC connection is left open.
+// clearObject nils out the singleton and any state that belongs to it.  Called with modeMutex write-locked.
+func (factory *SdkAbstractFactoryImpl) destroyObject(ctx context.Context, object destroyable, once *successOnce, clearObject func()) error {
+	if !once.isDone() {
+		return nil
+	}
+	var err error
+	if factory.grpcConnection == nil {
+		err = object.Destroy(ctx)
+	}
+	factory.removeObservedObject(object)
+	clearObject()
+	*once = successOnce{}
+	return err
+}
+
+// Run the OnClose hooks, most recently registered first, and return their errors.
+// Called by destroy before any object or connection is released.
+func (factory *SdkAbstractFactoryImpl) runOnCloseHooks(ctx context.Context) []error {
+	factory.onCloseMutex.Lock()
+	hooks := append([]func(ctx context.Context) error{}, factory.onCloseHooks...)
+	factory.onCloseMutex.Unlock()
+	var errs []error
+	for i := len(hooks) - 1; i >= 0; i-- {
+		errs = append(errs, hooks[i](ctx))
+	}
+	return errs
+}
+
+// ----------------------------------------------------------------------------
+// Interface methods
+// ----------------------------------------------------------------------------
+
+/*
+The DestroyG2config method destroys only the G2config object, as Destroy would.
+The next GetG2config call creates a new one; the other objects and the gRPC connection are kept.
+GetG2* calls made during DestroyG2config wait for it to finish; otherwise, DestroyG2config must not be
+called concurrently with other factory methods.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (factory *SdkAbstractFactoryImpl) DestroyG2config(ctx context.Context) error {
+	ctx = factory.getContext(ctx)
+	factory.modeMutex.Lock()
+	defer factory.modeMutex.Unlock()
+	return factory.destroyObject(ctx, factory.g2configSingleton, &factory.g2configSyncOnce, func() {
+		factory.g2configSingleton = nil
+	})
+}
+
+/*
+The DestroyG2configmgr method destroys only the G2configmgr object, as Destroy would.
+The next GetG2configmgr call creates a new one; the other objects and the gRPC connection are kept.
+GetG2* calls made during DestroyG2configmgr wait for it to finish; otherwise, DestroyG2configmgr must not be
+called concurrently with other factory methods.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (factory *SdkAbstractFactoryImpl) DestroyG2configmgr(ctx context.Context) error {
+	ctx = factory.getContext(ctx)
+	factory.modeMutex.Lock()
+	defer factory.modeMutex.Unlock()
+	return factory.destroyObject(ctx, factory.g2configmgrSingleton, &factory.g2configmgrSyncOnce, func() {
+		factory.g2configmgrSingleton = nil
+	})
+}
+
+/*
+The DestroyG2diagnostic method destroys only the G2diagnostic object, as Destroy would.
+The next GetG2diagnostic call creates a new one; the other objects and the gRPC connection are kept.
+GetG2* calls made during DestroyG2diagnostic wait for it to finish; otherwise, DestroyG2diagnostic must not be
+called concurrently with other factory methods.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (factory *SdkAbstractFactoryImpl) DestroyG2diagnostic(ctx context.Context) error {
+	ctx = factory.getContext(ctx)
+	factory.modeMutex.Lock()
+	defer factory.modeMutex.Unlock()
+	return factory.destroyObject(ctx, factory.g2diagnosticSingleton, &factory.g2diagnosticSyncOnce, func() {
+		factory.g2diagnosticSingleton = nil
+	})
+}
+
+/*
+The DestroyG2engine method destroys only the G2engine object, as Destroy would,
+e.g. to rebuild the engine after a configuration change.
+The next GetG2engine or GetG2engineWithConfigID call creates a new one;
+the other objects and the gRPC connection are kept.
+GetG2* calls made during DestroyG2engine wait for it to finish; otherwise, DestroyG2engine must not be
+called concurrently with other factory methods.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (factory *SdkAbstractFactoryImpl) DestroyG2engine(ctx context.Context) error {
+	ctx = factory.getContext(ctx)
+	factory.modeMutex.Lock()
+	defer factory.modeMutex.Unlock()
+	return factory.destroyObject(ctx, factory.g2engineSingleton, &factory.g2engineSyncOnce, func() {
+		factory.g2engineConfigID = 0
+		factory.g2engineSingleton = nil
+	})
+}
+
+/*
+The DestroyG2product method destroys only the G2product object, as Destroy would.
+The next GetG2product call creates a new one; the other objects and the gRPC connection are kept.
+GetG2* calls made during DestroyG2product wait for it to finish; otherwise, DestroyG2product must not be
+called concurrently with other factory methods.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (factory *SdkAbstractFactoryImpl) DestroyG2product(ctx context.Context) error {
+	ctx = factory.getContext(ctx)
+	factory.modeMutex.Lock()
+	defer factory.modeMutex.Unlock()
+	return factory.destroyObject(ctx, factory.g2productSingleton, &factory.g2productSyncOnce, func() {
+		factory.g2productSingleton = nil
+	})
+}
+
+/*
+The OnClose method registers hook to run during Destroy, and Reset with destroy,
+before the Senzing objects are destroyed and the gRPC connection is closed,
+e.g. to flush buffers or deregister the instance.
+Hooks run in reverse order of registration; their errors are joined with Destroy's.
+Hooks stay registered, so they run again on each later Destroy.
+They run with the factory locked, so they must not call the factory's methods;
+objects obtained from it beforehand may still be used.
+
+Input
+  - hook: The function to call with the context passed to Destroy; nil is ignored.
+*/
+func (factory *SdkAbstractFactoryImpl) OnClose(hook func(ctx context.Context) error) {
+	if hook == nil {
+		return
+	}
+	factory.onCloseMutex.Lock()
+	defer factory.onCloseMutex.Unlock()
+	factory.onCloseHooks = append(factory.onCloseHooks, hook)
+}
@@ -0,0 +1,157 @@
+package factory
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/connectivity"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSdkAbstractFactoryImpl_DestroyG2engine(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &destroyG2engine{}
+	testObject := getTestObjectWithG2engine(g2engine)
+	err := testObject.DestroyG2engine(ctx)
+	testError(test, ctx, err)
+	assert.Equal(test, 1, g2engine.destroyCount)
+	_, created := testObject.G2engine()
+	assert.False(test, created)
+	assert.Empty(test, testObject.CreatedObjects(ctx))
+
+	// A second call has nothing to destroy.
+
+	err = testObject.DestroyG2engine(ctx)
+	testError(test, ctx, err)
+	assert.Equal(test, 1, g2engine.destroyCount)
+}
+
+func TestSdkAbstractFactoryImpl_DestroyG2engine_keepsOtherObjects(test *testing.T) {
+	ctx := context.TODO()
+	testObject := &SdkAbstractFactoryImpl{
+		NullBackend: true,
+	}
+	defer testObject.Destroy(ctx)
+	g2engine1, err := testObject.GetG2engine(ctx)
+	testError(test, ctx, err)
+	g2product1, err := testObject.GetG2product(ctx)
+	testError(test, ctx, err)
+	err = testObject.DestroyG2engine(ctx)
+	testError(test, ctx, err)
+	g2engine2, err := testObject.GetG2engine(ctx)
+	testError(test, ctx, err)
+	assert.NotSame(test, g2engine1, g2engine2, "GetG2engine did not rebuild the engine")
+	g2product2, err := testObject.GetG2product(ctx)
+	testError(test, ctx, err)
+	assert.Same(test, g2product1, g2product2)
+	assert.Len(test, testObject.CreatedObjects(ctx), 2)
+}
+
+func TestSdkAbstractFactoryImpl_DestroyG2engine_gRPC(test *testing.T) {
+	ctx := context.TODO()
+	testObject := &SdkAbstractFactoryImpl{
+		GrpcAddress: startTestGrpcServer(test),
+	}
+	defer testObject.Destroy(ctx)
+	_, err := testObject.GetG2engine(ctx)
+	testError(test, ctx, err)
+	g2product1, err := testObject.GetG2product(ctx)
+	testError(test, ctx, err)
+	grpcConnection := testObject.grpcConnection
+	err = testObject.DestroyG2engine(ctx)
+	testError(test, ctx, err)
+	assert.Same(test, grpcConnection, testObject.grpcConnection)
+	assert.NotEqual(test, connectivity.Shutdown, grpcConnection.GetState())
+	err = checkTestGrpcConnection(ctx, testObject)
+	testError(test, ctx, err)
+	_, err = testObject.GetG2engine(ctx)
+	testError(test, ctx, err)
+	g2product2, err := testObject.GetG2product(ctx)
+	testError(test, ctx, err)
+	assert.Same(test, g2product1, g2product2)
+}
+
+func TestSdkAbstractFactoryImpl_DestroyG2engine_concurrentGetters(test *testing.T) {
+	ctx := context.TODO()
+	testObject := &SdkAbstractFactoryImpl{NullBackend: true}
+	var waitGroup sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		waitGroup.Add(2)
+		go func() {
+			defer waitGroup.Done()
+			for j := 0; j < 100; j++ {
+				_, err := testObject.GetG2engine(ctx)
+				assert.NoError(test, err)
+			}
+		}()
+		go func() {
+			defer waitGroup.Done()
+			for j := 0; j < 100; j++ {
+				assert.NoError(test, testObject.DestroyG2engine(ctx))
+			}
+		}()
+	}
+	waitGroup.Wait()
+}
+
+func TestSdkAbstractFactoryImpl_OnClose(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &destroyG2engine{}
+	testObject := getTestObjectWithG2engine(g2engine)
+	errFirst := errors.New("first hook failed")
+	errThird := errors.New("third hook failed")
+	var calls []string
+	destroyCountInHook := -1
+	testObject.OnClose(func(ctx context.Context) error {
+		calls = append(calls, "first")
+		return errFirst
+	})
+	testObject.OnClose(func(ctx context.Context) error {
+		destroyCountInHook = g2engine.destroyCount
+		calls = append(calls, "second")
+		return nil
+	})
+	testObject.OnClose(nil)
+	testObject.OnClose(func(ctx context.Context) error {
+		calls = append(calls, "third")
+		return errThird
+	})
+
+	err := testObject.Destroy(ctx)
+	assert.ErrorIs(test, err, errFirst)
+	assert.ErrorIs(test, err, errThird)
+	assert.Equal(test, []string{"third", "second", "first"}, calls)
+	assert.Zero(test, destroyCountInHook, "hooks must run before the objects are destroyed")
+	assert.Equal(test, 1, g2engine.destroyCount, "a failing hook must not prevent the teardown")
+
+	// Hooks stay registered for the next Destroy, including Reset with destroy.
+
+	calls = nil
+	assert.ErrorIs(test, testObject.Reset(ctx, true), errThird)
+	assert.Equal(test, []string{"third", "second", "first"}, calls)
+	calls = nil
+	testError(test, ctx, testObject.Reset(ctx, false))
+	assert.Empty(test, calls)
+}
+
+func TestSdkAbstractFactoryImpl_OnClose_gRPC(test *testing.T) {
+	ctx := context.TODO()
+	testObject, err := New(WithGrpcAddress(startTestGrpcServer(test)))
+	testError(test, ctx, err)
+	_, err = testObject.GetG2engine(ctx)
+	testError(test, ctx, err)
+	grpcConnection, err := testObject.GrpcConnection(ctx)
+	testError(test, ctx, err)
+	testObject.OnClose(func(ctx context.Context) error {
+		assert.NotEqual(test, connectivity.Shutdown, grpcConnection.GetState(), "hooks must run before the connection is closed")
+		return nil
+	})
+	testError(test, ctx, testObject.Destroy(ctx))
+	assert.Equal(test, connectivity.Shutdown, grpcConnection.GetState())
+}
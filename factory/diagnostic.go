@@ -0,0 +1,53 @@
+package factory
+
+import (
+	"context"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// DiagnosticStats gathers the host resource figures reported by G2diagnostic.
+type DiagnosticStats struct {
+	AvailableMemory   int64 // Bytes, from G2diagnostic.GetAvailableMemory.
+	LogicalCores      int   // From G2diagnostic.GetLogicalCores.
+	PhysicalCores     int   // From G2diagnostic.GetPhysicalCores.
+	TotalSystemMemory int64 // Bytes, from G2diagnostic.GetTotalSystemMemory.
+}
+
+// ----------------------------------------------------------------------------
+// Interface methods
+// ----------------------------------------------------------------------------
+
+/*
+The DiagnosticStats method returns host resource figures as a typed structure.
+It obtains the G2diagnostic object from the factory and calls GetPhysicalCores,
+GetLogicalCores, GetTotalSystemMemory, and GetAvailableMemory.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The collected statistics.
+  - The first error encountered; the statistics are then incomplete.
+*/
+func (factory *SdkAbstractFactoryImpl) DiagnosticStats(ctx context.Context) (DiagnosticStats, error) {
+	ctx = factory.getContext(ctx)
+	result := DiagnosticStats{}
+	g2diagnostic, err := factory.GetG2diagnostic(ctx)
+	if err != nil {
+		return result, err
+	}
+	if result.PhysicalCores, err = g2diagnostic.GetPhysicalCores(ctx); err != nil {
+		return result, err
+	}
+	if result.LogicalCores, err = g2diagnostic.GetLogicalCores(ctx); err != nil {
+		return result, err
+	}
+	if result.TotalSystemMemory, err = g2diagnostic.GetTotalSystemMemory(ctx); err != nil {
+		return result, err
+	}
+	result.AvailableMemory, err = g2diagnostic.GetAvailableMemory(ctx)
+	return result, err
+}
@@ -0,0 +1,76 @@
+package factory
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/senzing/g2-sdk-go/g2api"
+	"github.com/stretchr/testify/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Test doubles
+// ----------------------------------------------------------------------------
+
+type statsG2diagnostic struct {
+	g2api.G2diagnostic
+	memoryErr error
+}
+
+func (g2diagnostic *statsG2diagnostic) GetAvailableMemory(ctx context.Context) (int64, error) {
+	return 4294967296, nil
+}
+
+func (g2diagnostic *statsG2diagnostic) GetLogicalCores(ctx context.Context) (int, error) {
+	return 16, nil
+}
+
+func (g2diagnostic *statsG2diagnostic) GetPhysicalCores(ctx context.Context) (int, error) {
+	return 8, nil
+}
+
+func (g2diagnostic *statsG2diagnostic) GetTotalSystemMemory(ctx context.Context) (int64, error) {
+	return 17179869184, g2diagnostic.memoryErr
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func getTestObjectWithG2diagnostic(g2diagnostic g2api.G2diagnostic) *SdkAbstractFactoryImpl {
+	result := &SdkAbstractFactoryImpl{}
+	result.g2diagnosticSyncOnce.Do(func() error {
+		result.g2diagnosticSingleton = g2diagnostic
+		result.addObservedObject(context.TODO(), g2diagnostic)
+		return nil
+	})
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSdkAbstractFactoryImpl_DiagnosticStats(test *testing.T) {
+	ctx := context.TODO()
+	testObject := getTestObjectWithG2diagnostic(&statsG2diagnostic{})
+	actual, err := testObject.DiagnosticStats(ctx)
+	testError(test, ctx, err)
+	expected := DiagnosticStats{
+		AvailableMemory:   4294967296,
+		LogicalCores:      16,
+		PhysicalCores:     8,
+		TotalSystemMemory: 17179869184,
+	}
+	assert.Equal(test, expected, actual)
+}
+
+func TestSdkAbstractFactoryImpl_DiagnosticStats_error(test *testing.T) {
+	ctx := context.TODO()
+	memoryErr := errors.New("memory unavailable")
+	testObject := getTestObjectWithG2diagnostic(&statsG2diagnostic{memoryErr: memoryErr})
+	actual, err := testObject.DiagnosticStats(ctx)
+	assert.ErrorIs(test, err, memoryErr)
+	assert.Equal(test, 8, actual.PhysicalCores)
+}
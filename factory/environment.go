@@ -0,0 +1,40 @@
+package factory
+
+import (
+	"github.com/senzing/go-logging/messagelogger"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// environmentLogger adds the factory's Environment to the details of every message; see WithEnvironment.
+type environmentLogger struct {
+	messagelogger.MessageLoggerInterface
+	environment string
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Return details with the environment appended.
+func (logger *environmentLogger) withEnvironment(details []interface{}) []interface{} {
+	return append(details, map[string]string{"environment": logger.environment})
+}
+
+// ----------------------------------------------------------------------------
+// Interface methods
+// ----------------------------------------------------------------------------
+
+func (logger *environmentLogger) Error(messageNumber int, details ...interface{}) error {
+	return logger.MessageLoggerInterface.Error(messageNumber, logger.withEnvironment(details)...)
+}
+
+func (logger *environmentLogger) Log(messageNumber int, details ...interface{}) error {
+	return logger.MessageLoggerInterface.Log(messageNumber, logger.withEnvironment(details)...)
+}
+
+func (logger *environmentLogger) Message(messageNumber int, details ...interface{}) (string, error) {
+	return logger.MessageLoggerInterface.Message(messageNumber, logger.withEnvironment(details)...)
+}
@@ -0,0 +1,51 @@
+package factory
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestWithEnvironment_log(test *testing.T) {
+	ctx := context.TODO()
+	logger := &recordingLogger{}
+	testObject := &SdkAbstractFactoryImpl{logger: logger}
+	testError(test, ctx, WithEnvironment("staging")(testObject))
+	testObject.getContext(nil)
+	require.Equal(test, []int{3001}, logger.messageNumbers)
+	assert.Contains(test, logger.details[0], map[string]string{"environment": "staging"})
+}
+
+func TestWithEnvironment_notify(test *testing.T) {
+	ctx := context.TODO()
+	testObject, err := New(WithNullBackend(), WithEnvironment("prod"))
+	testError(test, ctx, err)
+	anObserver := &recordingObserver{}
+	testError(test, ctx, testObject.RegisterObserver(ctx, anObserver))
+	_, err = testObject.GetG2engine(ctx)
+	testError(test, ctx, err)
+	require.Equal(test, 1, anObserver.countMessageId(test, "8004"))
+	details := map[string]string{}
+	require.NoError(test, json.Unmarshal([]byte(anObserver.messages[0]), &details))
+	assert.Equal(test, "prod", details["environment"])
+}
+
+func TestWithEnvironment_moduleName(test *testing.T) {
+	testObject := &SdkAbstractFactoryImpl{ModuleName: "my-app-{environment}"}
+	require.NoError(test, WithEnvironment("prod")(testObject))
+	assert.Equal(test, "my-app-prod", testObject.getLocalInitParameters().moduleNameFor("g2engine"))
+	require.NoError(test, WithModuleNameTemplate("my-app-{environment}-{object}")(testObject))
+	assert.Equal(test, "my-app-prod-g2engine", testObject.getLocalInitParameters().moduleNameFor("g2engine"))
+}
+
+func TestWithEnvironment_empty(test *testing.T) {
+	_, err := New(WithEnvironment(""))
+	assert.Error(test, err)
+}
@@ -0,0 +1,54 @@
+package factory
+
+import (
+	"errors"
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// The sentinel errors below are wrapped with the underlying cause, so callers can test
+// for them with errors.Is and still inspect the original error with errors.As.
+
+// ErrBaseContextDone is returned by the GetG2* methods once the context given to WithBaseContext is done.
+var ErrBaseContextDone = errors.New("factory base context is done")
+
+// ErrCircuitOpen is returned, without contacting the server, while the circuit breaker is open.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// ErrConflictingConfiguration is returned when mutually exclusive settings are combined,
+// e.g. a gRPC address together with local-only settings such as the engine configuration JSON.
+var ErrConflictingConfiguration = errors.New("conflicting factory configuration")
+
+// ErrDBPerformance is returned by Initialize when the database is slower than WithDBPerfGate requires.
+var ErrDBPerformance = errors.New("database performance below the required minimum")
+
+// ErrGrpcDial is returned by the GetG2* methods when the gRPC connection cannot be established.
+var ErrGrpcDial = errors.New("cannot connect to the Senzing gRPC server")
+
+// ErrIncompatibleVersion is returned by CheckCompatibility when the Senzing version is older than MinimumSenzingVersion.
+var ErrIncompatibleVersion = errors.New("incompatible Senzing version")
+
+// ErrInvalidEngineConfiguration is returned when the engine configuration JSON of a local factory
+// is empty, malformed, or lacks a required key.
+var ErrInvalidEngineConfiguration = errors.New("invalid engine configuration")
+
+// ErrLicenseExpired is returned by License and LicenseInfo.Err once the license's expiration date has passed,
+// and by Initialize for such a license with WithStrictLicense.
+var ErrLicenseExpired = errors.New("expired Senzing license")
+
+// ErrMissingGrpcServices is returned by VerifyGrpcServices when the gRPC server does not register
+// every one of SenzingGrpcServices.
+var ErrMissingGrpcServices = errors.New("missing Senzing gRPC services")
+
+// ErrNotInitialized is returned by the GetG2* methods when a local Senzing object fails to initialize,
+// and, with WithStrictEager, when they are called before Initialize.
+var ErrNotInitialized = errors.New("cannot initialize Senzing object")
+
+// ErrNotReady is returned by WaitUntilReady when HealthCheck still fails once the timeout has elapsed.
+var ErrNotReady = errors.New("backend is not ready")
+
+// ErrUnsupportedMode is returned when a method is not available for the factory's mode,
+// e.g. GetG2engineWithConfigID on a gRPC factory.
+var ErrUnsupportedMode = errors.New("unsupported in this factory mode")
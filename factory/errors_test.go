@@ -0,0 +1,48 @@
+package factory
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestErrGrpcDial(test *testing.T) {
+	ctx := context.TODO()
+	dialErr := errors.New("server restarting")
+	testObject := &SdkAbstractFactoryImpl{
+		GrpcDialer: func(ctx context.Context) (*grpc.ClientConn, error) {
+			return nil, dialErr
+		},
+	}
+	_, err := testObject.GetG2engine(ctx)
+	assert.ErrorIs(test, err, ErrGrpcDial)
+	assert.ErrorIs(test, err, dialErr)
+}
+
+func TestErrNotInitialized(test *testing.T) {
+	ctx := context.TODO()
+	testObject := &SdkAbstractFactoryImpl{
+		EngineConfigurationJson: "{}",
+		ModuleName:              moduleName,
+		VerboseLogging:          verboseLogging,
+	}
+	defer testObject.Destroy(ctx)
+	_, err := testObject.GetG2product(ctx)
+	assert.ErrorIs(test, err, ErrNotInitialized)
+}
+
+func TestErrUnsupportedMode(test *testing.T) {
+	ctx := context.TODO()
+	testObject := &SdkAbstractFactoryImpl{
+		GrpcAddress: "localhost:8258",
+	}
+	_, err := testObject.GetG2engineWithConfigID(ctx, 4015588140)
+	assert.ErrorIs(test, err, ErrUnsupportedMode)
+}
@@ -0,0 +1,98 @@
+package factory
+
+import (
+	"context"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/senzing/g2-sdk-go/g2api"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// exportReader adapts the G2engine export-handle/FetchNext cursor to an io.ReadCloser.
+type exportReader struct {
+	buffer         string
+	closeOnce      sync.Once
+	closeErr       error
+	ctx            context.Context
+	done           bool
+	g2engine       g2api.G2engine
+	responseHandle uintptr
+}
+
+// ----------------------------------------------------------------------------
+// Interface methods
+// ----------------------------------------------------------------------------
+
+// Read fills p with newline-delimited JSON entities, fetching more from the engine as needed.
+func (reader *exportReader) Read(p []byte) (int, error) {
+	for len(reader.buffer) == 0 {
+		if reader.done {
+			return 0, io.EOF
+		}
+		line, err := reader.g2engine.FetchNext(reader.ctx, reader.responseHandle)
+		if err != nil {
+			return 0, err
+		}
+		if len(line) == 0 {
+			reader.done = true
+			continue
+		}
+		if !strings.HasSuffix(line, "\n") {
+			line = line + "\n"
+		}
+		reader.buffer = line
+	}
+	count := copy(p, reader.buffer)
+	reader.buffer = reader.buffer[count:]
+	return count, nil
+}
+
+// Close releases the export handle.  It is safe to call Close more than once.
+func (reader *exportReader) Close() error {
+	reader.closeOnce.Do(func() {
+		reader.closeErr = reader.g2engine.CloseExport(reader.ctx, reader.responseHandle)
+	})
+	return reader.closeErr
+}
+
+// ----------------------------------------------------------------------------
+// Factory methods
+// ----------------------------------------------------------------------------
+
+/*
+The ExportEntities method streams the entities in the Senzing repository as
+newline-delimited JSON.
+It wraps G2engine's ExportJSONEntityReport / FetchNext / CloseExport cursor
+so that callers can use a standard io.Reader regardless of backend.
+The caller must Close the returned reader to release the export handle.
+
+Input
+  - ctx: A context to control lifecycle.
+  - flags: Flags passed to ExportJSONEntityReport to control the export. Example: FlagsExportIncludeAllEntities.
+    FlagsDefault selects the flags set by WithDefaultEngineFlags.
+
+Output
+  - An io.ReadCloser yielding one JSON document per line.
+*/
+func (factory *SdkAbstractFactoryImpl) ExportEntities(ctx context.Context, flags Flags) (io.ReadCloser, error) {
+	ctx = factory.getContext(ctx)
+	g2engine, err := factory.GetG2engine(ctx)
+	if err != nil {
+		return nil, err
+	}
+	responseHandle, err := g2engine.ExportJSONEntityReport(ctx, factory.engineFlags(flags).Int64())
+	if err != nil {
+		return nil, err
+	}
+	result := &exportReader{
+		ctx:            ctx,
+		g2engine:       g2engine,
+		responseHandle: responseHandle,
+	}
+	return result, nil
+}
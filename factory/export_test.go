@@ -0,0 +1,84 @@
+package factory
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/senzing/g2-sdk-go/g2api"
+	"github.com/stretchr/testify/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Test doubles
+// ----------------------------------------------------------------------------
+
+type exportG2engine struct {
+	g2api.G2engine
+	closeCount int
+	flags      int64
+	lines      []string
+}
+
+func (g2engine *exportG2engine) ExportJSONEntityReport(ctx context.Context, flags int64) (uintptr, error) {
+	g2engine.flags = flags
+	return 1, nil
+}
+
+func (g2engine *exportG2engine) FetchNext(ctx context.Context, responseHandle uintptr) (string, error) {
+	if len(g2engine.lines) == 0 {
+		return "", nil
+	}
+	result := g2engine.lines[0]
+	g2engine.lines = g2engine.lines[1:]
+	return result, nil
+}
+
+func (g2engine *exportG2engine) CloseExport(ctx context.Context, responseHandle uintptr) error {
+	g2engine.closeCount++
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestExportReader_Read(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &exportG2engine{
+		lines: []string{`{"RESOLVED_ENTITY":{"ENTITY_ID":1}}` + "\n", `{"RESOLVED_ENTITY":{"ENTITY_ID":2}}`},
+	}
+	reader := &exportReader{
+		ctx:      ctx,
+		g2engine: g2engine,
+	}
+	actual, err := io.ReadAll(reader)
+	testError(test, ctx, err)
+	assert.Equal(test, "{\"RESOLVED_ENTITY\":{\"ENTITY_ID\":1}}\n{\"RESOLVED_ENTITY\":{\"ENTITY_ID\":2}}\n", string(actual))
+	testError(test, ctx, reader.Close())
+	testError(test, ctx, reader.Close())
+	assert.Equal(test, 1, g2engine.closeCount)
+}
+
+func TestSdkAbstractFactoryImpl_ExportEntities_defaultEngineFlags(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &exportG2engine{}
+	testObject := getTestObjectWithG2engine(g2engine)
+	err := WithDefaultEngineFlags(FlagsExportIncludeAllEntities)(testObject)
+	testError(test, ctx, err)
+
+	reader, err := testObject.ExportEntities(ctx, FlagsDefault)
+	testError(test, ctx, err)
+	testError(test, ctx, reader.Close())
+	assert.Equal(test, FlagsExportIncludeAllEntities.Int64(), g2engine.flags)
+
+	reader, err = testObject.ExportEntities(ctx, FlagsExportIncludePossiblySame)
+	testError(test, ctx, err)
+	testError(test, ctx, reader.Close())
+	assert.Equal(test, FlagsExportIncludePossiblySame.Int64(), g2engine.flags, "explicit flags must override the default")
+
+	reader, err = testObject.ExportEntities(ctx, FlagsNone)
+	testError(test, ctx, err)
+	testError(test, ctx, reader.Close())
+	assert.Equal(test, int64(0), g2engine.flags, "FlagsNone must not select the default")
+}
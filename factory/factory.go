@@ -2,7 +2,13 @@ package factory
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	g2configbase "github.com/senzing/g2-sdk-go-base/g2config"
 	g2configmgrbase "github.com/senzing/g2-sdk-go-base/g2configmgr"
@@ -21,64 +27,368 @@ import (
 	g2enginepb "github.com/senzing/g2-sdk-proto/go/g2engine"
 	g2productpb "github.com/senzing/g2-sdk-proto/go/g2product"
 	"github.com/senzing/go-logging/messagelogger"
+	"github.com/senzing/go-observing/observer"
+	"go.opentelemetry.io/otel/metric"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/stats"
 )
 
 // ----------------------------------------------------------------------------
 // Types
 // ----------------------------------------------------------------------------
 
-// SdkAbstractFactoryImpl is the default implementation of the SdkAbstractFactory interface.
+// SdkAbstractFactoryImpl is the default implementation of the SdkAbstractFactory interface
+// and of every optional interface; see ManagedSdkAbstractFactory.
 type SdkAbstractFactoryImpl struct {
-	g2configmgrSingleton  g2api.G2configmgr
-	g2configmgrSyncOnce   sync.Once
-	g2configSingleton     g2api.G2config
-	g2configSyncOnce      sync.Once
-	g2diagnosticSingleton g2api.G2diagnostic
-	g2diagnosticSyncOnce  sync.Once
-	g2engineSingleton     g2api.G2engine
-	g2engineSyncOnce      sync.Once
-	g2productSingleton    g2api.G2product
-	g2productSyncOnce     sync.Once
-	GrpcAddress           string
-	GrpcOptions           []grpc.DialOption
-	logger                messagelogger.MessageLoggerInterface
+	baseContextCancel           context.CancelFunc
+	BaseContext                 context.Context
+	baseContextGeneration       uint64
+	baseContextSyncOnce         sync.Once
+	circuitBreaker              *circuitBreaker
+	circuitBreakerSyncOnce      sync.Once
+	Clock                       Clock
+	CreationBackoff             Backoff
+	CircuitBreaker              *CircuitBreakerSettings
+	DBPerfGate                  *DBPerfGateSettings
+	DefaultConfigJson           string
+	DefaultEngineFlags          Flags
+	EagerInitialization         bool
+	EngineConfigurationJson     string
+	Environment                 string
+	g2configmgrSingleton        g2api.G2configmgr
+	g2configmgrSyncOnce         successOnce
+	g2configSingleton           g2api.G2config
+	g2configSyncOnce            successOnce
+	g2diagnosticSingleton       g2api.G2diagnostic
+	g2diagnosticSyncOnce        successOnce
+	g2engineConfigID            int64
+	g2engineSingleton           g2api.G2engine
+	g2engineSyncOnce            successOnce
+	g2productSingleton          g2api.G2product
+	g2productSyncOnce           successOnce
+	GracefulShutdownTimeout     time.Duration
+	GrpcAddress                 string
+	GrpcAuthority               string
+	GrpcCompressor              string
+	GrpcConnectBackoff          *backoff.Config
+	grpcConnection              *grpc.ClientConn
+	grpcConnectionSyncOnce      successOnce
+	GrpcConnectionStateCallback func(state connectivity.State)
+	GrpcDialer                  func(ctx context.Context) (*grpc.ClientConn, error)
+	grpcDialOptions             []grpc.DialOption
+	GrpcDialOptionsFromEnv      bool
+	GrpcDialTimeout             *time.Duration
+	GrpcDisableServiceConfig    bool
+	grpcInFlightCalls           atomic.Int64
+	initialized                 atomic.Bool
+	initializeMutex             sync.Mutex
+	GrpcKeepalive               *keepalive.ClientParameters
+	GrpcLoadBalancingPolicy     string
+	GrpcMaxRecvMsgSize          int
+	GrpcMaxSendMsgSize          int
+	GrpcMetadata                metadata.MD
+	GrpcOptions                 []grpc.DialOption
+	GrpcProxy                   func(address string) (*url.URL, error)
+	GrpcRetryAttempts           int
+	GrpcRetryBackoff            time.Duration
+	GrpcSharedConnection        *grpc.ClientConn
+	grpcStateWatchStop          func()
+	GrpcStatsHandlers           []stats.Handler
+	GrpcStreamInterceptors      []grpc.StreamClientInterceptor
+	GrpcTLSFiles                []string
+	GrpcTransportCredentials    credentials.TransportCredentials
+	GrpcUnaryInterceptors       []grpc.UnaryClientInterceptor
+	GrpcWaitForReady            bool
+	licenseCache                *LicenseInfo
+	licenseCachedAt             time.Time
+	LicenseCacheTTL             *time.Duration
+	licenseMutex                sync.Mutex
+	LocalBackend                bool
+	logger                      messagelogger.MessageLoggerInterface
+	loggerSyncOnce              sync.Once
+	Metrics                     MetricsRecorder
+	modeMutex                   sync.RWMutex
+	ModuleName                  string
+	ModuleNameTemplate          string
+	nativeInitParameters        localInitParameters
+	nativeInitSyncOnce          successOnce
+	NullBackend                 bool
+	observedObjects             []observable
+	ObserverBufferSize          int
+	observerQueues              map[observer.Observer]*observerQueue
+	observers                   []observer.Observer
+	observersMutex              sync.Mutex
+	onCloseHooks                []func(ctx context.Context) error
+	onCloseMutex                sync.Mutex
+	OnUnauthenticated           func(ctx context.Context) error
+	OperationTimeouts           map[OperationKind]time.Duration
+	otelCounterOnce             sync.Once
+	OtelMeterProvider           metric.MeterProvider
+	otelObjectsCreated          metric.Int64Counter
+	PurgeOnInit                 bool
+	PurgeOnInitConfirmed        bool
+	SharedNativeInit            bool
+	StrictEager                 bool
+	StrictLicense               bool
+	VerboseLogging              int
 }
 
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var _ ManagedSdkAbstractFactory = (*SdkAbstractFactoryImpl)(nil)
+
 // ----------------------------------------------------------------------------
 // Internal methods
 // ----------------------------------------------------------------------------
 
-// Get the gRPC connection.
-func (factory *SdkAbstractFactoryImpl) getGrpcConnection(ctx context.Context) *grpc.ClientConn {
-	if factory.GrpcOptions == nil {
-		factory.GrpcOptions = []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
-	}
-	result, err := grpc.DialContext(ctx, factory.GrpcAddress, factory.GrpcOptions...)
+// Get the gRPC connection shared by all gRPC clients of the factory.
+// GrpcSharedConnection is used as is; otherwise the connection is dialed, making up to
+// GrpcRetryAttempts attempts with the waits chosen by CreationBackoff, by default exponential
+// backoff with jitter starting at GrpcRetryBackoff.
+func (factory *SdkAbstractFactoryImpl) getGrpcConnection(ctx context.Context) (*grpc.ClientConn, error) {
+	err := factory.grpcConnectionSyncOnce.Do(func() error {
+		if factory.GrpcSharedConnection != nil {
+			factory.grpcConnection = factory.GrpcSharedConnection
+			factory.watchGrpcConnectionState(factory.grpcConnection)
+			return nil
+		}
+		backoff := factory.getCreationBackoff()
+		for attempt := 1; ; attempt++ {
+			grpcConnection, err := factory.dialGrpcConnection(ctx)
+			if err == nil {
+				factory.grpcConnection = grpcConnection
+				factory.watchGrpcConnectionState(factory.grpcConnection)
+				return nil
+			}
+			if attempt >= factory.GrpcRetryAttempts {
+				factory.getLogger().Log(4010, err)
+				return fmt.Errorf("%w: %w", ErrGrpcDial, err)
+			}
+			select {
+			case <-ctx.Done():
+				err = errors.Join(ctx.Err(), err)
+				factory.getLogger().Log(4010, err)
+				return fmt.Errorf("%w: %w", ErrGrpcDial, err)
+			case <-factory.getClock().After(backoff.Next(attempt)):
+			}
+		}
+	})
 	if err != nil {
-		factory.getLogger().Log(4010, err)
+		return nil, err
 	}
-	return result
+	return factory.grpcConnection, nil
 }
 
-// Get the Logger singleton.
-func (factory *SdkAbstractFactoryImpl) getLogger() messagelogger.MessageLoggerInterface {
-	if factory.logger == nil {
-		factory.logger, _ = messagelogger.NewSenzingApiLogger(ProductId, IdMessages, IdStatuses, messagelogger.LevelInfo)
+// Log which backend was used to create a Senzing object.
+func (factory *SdkAbstractFactoryImpl) logBackend(objectName string) {
+	switch factory.mode() {
+	case ModeGrpc:
+		factory.getLogger().Log(2002, objectName, factory.grpcConnection.Target())
+	case ModeNull:
+		factory.getLogger().Log(2003, objectName)
+	default:
+		factory.getLogger().Log(2001, objectName)
+	}
+}
+
+// Replace a nil context with context.Background() so callers' mistakes do not cause a panic.
+func (factory *SdkAbstractFactoryImpl) getContext(ctx context.Context) context.Context {
+	if ctx == nil {
+		factory.getLogger().Log(3001)
+		return context.Background()
 	}
+	return ctx
+}
+
+// Get the Logger singleton.  Concurrent getters may log while creating their objects,
+// so creation is guarded by loggerSyncOnce.
+func (factory *SdkAbstractFactoryImpl) getLogger() messagelogger.MessageLoggerInterface {
+	factory.loggerSyncOnce.Do(func() {
+		if factory.logger == nil {
+			factory.logger, _ = messagelogger.NewSenzingApiLogger(ProductId, IdMessages, IdStatuses, messagelogger.LevelInfo)
+		}
+		if len(factory.Environment) > 0 {
+			factory.logger = &environmentLogger{MessageLoggerInterface: factory.logger, environment: factory.Environment}
+		}
+	})
 	return factory.logger
 }
 
+// Release the resources held by the factory, as described for Destroy.
+// Called with modeMutex write-locked.
+func (factory *SdkAbstractFactoryImpl) destroy(ctx context.Context) error {
+	errs := factory.runOnCloseHooks(ctx)
+	if factory.grpcConnection == nil {
+		if factory.g2productSingleton != nil {
+			errs = append(errs, factory.g2productSingleton.Destroy(ctx))
+		}
+		if factory.g2engineSingleton != nil {
+			errs = append(errs, factory.g2engineSingleton.Destroy(ctx))
+		}
+		if factory.g2diagnosticSingleton != nil {
+			errs = append(errs, factory.g2diagnosticSingleton.Destroy(ctx))
+		}
+		if factory.g2configmgrSingleton != nil {
+			errs = append(errs, factory.g2configmgrSingleton.Destroy(ctx))
+		}
+		if factory.g2configSingleton != nil {
+			errs = append(errs, factory.g2configSingleton.Destroy(ctx))
+		}
+	} else if factory.grpcConnection != factory.GrpcSharedConnection {
+		factory.waitForGrpcCalls()
+		errs = append(errs, factory.grpcConnection.Close())
+	}
+	factory.reset()
+	err := errors.Join(errs...)
+	factory.notify(ctx, 8006, err, map[string]string{})
+	factory.flushNotifications()
+	return err
+}
+
+// Reset the factory, as described for Reset.  Called with modeMutex write-locked.
+func (factory *SdkAbstractFactoryImpl) resetObjects(ctx context.Context, destroy bool) error {
+	var err error = nil
+	if destroy {
+		err = factory.destroy(ctx)
+	} else {
+		factory.reset()
+	}
+	factory.notify(ctx, 8007, err, map[string]string{"destroy": strconv.FormatBool(destroy)})
+	return err
+}
+
+// Forget the created objects and the gRPC connection without destroying them,
+// so subsequent GetG2* calls create new objects from the current field values.
+func (factory *SdkAbstractFactoryImpl) reset() {
+	factory.initialized.Store(false)
+	factory.stopBaseContextWatch()
+	if factory.grpcStateWatchStop != nil {
+		factory.grpcStateWatchStop()
+		factory.grpcStateWatchStop = nil
+	}
+	factory.grpcConnection = nil
+	factory.grpcConnectionSyncOnce = successOnce{}
+
+	factory.g2configSingleton = nil
+	factory.g2configSyncOnce = successOnce{}
+	factory.g2configmgrSingleton = nil
+	factory.g2configmgrSyncOnce = successOnce{}
+	factory.g2diagnosticSingleton = nil
+	factory.g2diagnosticSyncOnce = successOnce{}
+	factory.g2engineConfigID = 0
+	factory.g2engineSingleton = nil
+	factory.g2engineSyncOnce = successOnce{}
+	factory.g2productSingleton = nil
+	factory.g2productSyncOnce = successOnce{}
+	factory.licenseMutex.Lock()
+	factory.licenseCache = nil
+	factory.licenseMutex.Unlock()
+	factory.nativeInitParameters = localInitParameters{}
+	factory.nativeInitSyncOnce = successOnce{}
+	factory.clearObservedObjects()
+}
+
 // ----------------------------------------------------------------------------
 // Interface methods
 // ----------------------------------------------------------------------------
 
+/*
+The ActiveConfigID method returns the identifier of the configuration used by the G2engine.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The configuration identifier returned by G2engine.GetActiveConfigID.
+*/
+func (factory *SdkAbstractFactoryImpl) ActiveConfigID(ctx context.Context) (int64, error) {
+	ctx = factory.getContext(ctx)
+	g2engine, err := factory.GetG2engine(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return g2engine.GetActiveConfigID(ctx)
+}
+
+/*
+The CreatedObjects method returns the Senzing objects that have already been
+created by the GetG2* methods, in the order G2config, G2configmgr, G2diagnostic,
+G2engine, G2product.
+Objects that have not been requested are omitted; no new objects are created.
+This lets cross-cutting code (logging, destroy, observer registration) loop over
+objects instead of repeating per-object logic.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - A slice of the already-created G2* objects.
+*/
+func (factory *SdkAbstractFactoryImpl) CreatedObjects(ctx context.Context) []interface{} {
+	factory.modeMutex.RLock()
+	defer factory.modeMutex.RUnlock()
+	result := []interface{}{}
+	if factory.g2configSyncOnce.isDone() {
+		result = append(result, factory.g2configSingleton)
+	}
+	if factory.g2configmgrSyncOnce.isDone() {
+		result = append(result, factory.g2configmgrSingleton)
+	}
+	if factory.g2diagnosticSyncOnce.isDone() {
+		result = append(result, factory.g2diagnosticSingleton)
+	}
+	if factory.g2engineSyncOnce.isDone() {
+		result = append(result, factory.g2engineSingleton)
+	}
+	if factory.g2productSyncOnce.isDone() {
+		result = append(result, factory.g2productSingleton)
+	}
+	return result
+}
+
+/*
+The Destroy method releases the resources held by the factory.
+For the local backend, Destroy is called on every Senzing object the factory created.
+For the gRPC backend, the objects are left alone (destroying them would affect the
+server) and the shared gRPC connection is closed, unless it was provided with
+GrpcSharedConnection, in which case its owner closes it.
+The backend is the one the objects were created with, even if GrpcAddress has changed since.
+Hooks registered with OnClose run first, in reverse order of registration.
+With WithGracefulShutdown, in-flight gRPC calls may finish before the connection is closed.
+With WithObserverBuffer, Destroy returns after the queued notifications, including its own,
+have been delivered, so it must not be called from an observer.
+After Destroy returns, the factory is back in its initial state: subsequent GetG2*
+calls lazily create new objects.
+GetG2* calls made during Destroy wait for it to finish; otherwise, Destroy must not be
+called concurrently with other factory methods.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - All errors encountered, joined with errors.Join.
+*/
+func (factory *SdkAbstractFactoryImpl) Destroy(ctx context.Context) error {
+	ctx = factory.getContext(ctx)
+	factory.modeMutex.Lock()
+	defer factory.modeMutex.Unlock()
+	return factory.destroy(ctx)
+}
+
 /*
 The GetG2config method returns a G2config object based on the
 information passed in the SdkAbstractFactoryImpl structure.
-If GrpcAddress is spectified, an implementation that communicates over gRPC will be returned.
-If GrpcAddress is empty, an implementation that uses a local Senzing Go SDK will be returned.
+If GrpcAddress is spectified, or a gRPC connection or dialer is provided,
+an implementation that communicates over gRPC will be returned.
+Otherwise, an implementation that uses a local Senzing Go SDK will be returned,
+initialized with ModuleName, EngineConfigurationJson, and VerboseLogging.
 
 Input
   - ctx: A context to control lifecycle.
@@ -88,25 +398,116 @@ Output
     See the example output.
 */
 func (factory *SdkAbstractFactoryImpl) GetG2config(ctx context.Context) (g2api.G2config, error) {
-	var err error = nil
-	factory.g2configSyncOnce.Do(func() {
-		if len(factory.GrpcAddress) > 0 {
-			grpcConnection := factory.getGrpcConnection(ctx)
+	ctx = factory.getContext(ctx)
+	if err := factory.initializeIfPending(ctx, "GetG2config"); err != nil {
+		return nil, err
+	}
+	factory.modeMutex.RLock()
+	defer factory.modeMutex.RUnlock()
+	if err := factory.watchBaseContext(); err != nil {
+		return nil, err
+	}
+	var observerErr error = nil
+	err := factory.g2configSyncOnce.Do(func() error {
+		if factory.NullBackend {
+			factory.g2configSingleton = &nullG2config{}
+		} else if factory.isGrpc() {
+			grpcConnection, err := factory.getGrpcConnection(ctx)
+			if err != nil {
+				return err
+			}
 			factory.g2configSingleton = &g2configgrpc.G2config{
 				GrpcClient: g2configpb.NewG2ConfigClient(grpcConnection),
 			}
 		} else {
-			factory.g2configSingleton = &g2configbase.G2config{}
+			g2config := &g2configbase.G2config{}
+			initParameters := factory.getLocalInitParameters()
+			err := g2config.Init(ctx, initParameters.moduleNameFor("g2config"), initParameters.engineConfigurationJson, initParameters.verboseLogging)
+			if err != nil {
+				factory.getLogger().Log(4001, err)
+				return fmt.Errorf("%w: G2config.Init: %w", ErrNotInitialized, err)
+			}
+			factory.g2configSingleton = g2config
 		}
+		observerErr = factory.addObservedObject(ctx, factory.g2configSingleton)
+		factory.notify(ctx, 8001, observerErr, map[string]string{})
+		factory.recordCreation("G2config")
+		return nil
 	})
-	return factory.g2configSingleton, err
+	if err != nil {
+		return nil, err
+	}
+	return factory.g2configSingleton, observerErr
+}
+
+/*
+The GetG2configForConfigID method returns the G2config object together with a
+configuration handle holding the configuration stored under configID.
+The stored configuration is fetched with G2configmgr.GetConfig and loaded with
+G2config.Load, so operations using the handle target that configuration rather
+than the default one.
+The caller is responsible for calling G2config.Close on the returned handle.
+
+Input
+  - ctx: A context to control lifecycle.
+  - configID: The identifier of a configuration stored in the Senzing repository.
+
+Output
+  - The G2config object.
+  - A configuration handle for the loaded configuration.
+*/
+func (factory *SdkAbstractFactoryImpl) GetG2configForConfigID(ctx context.Context, configID int64) (g2api.G2config, uintptr, error) {
+	ctx = factory.getContext(ctx)
+	g2config, err := factory.GetG2config(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	g2configmgr, err := factory.GetG2configmgr(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	configStr, err := g2configmgr.GetConfig(ctx, configID)
+	if err != nil {
+		return nil, 0, err
+	}
+	configHandle, err := g2config.Create(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	err = g2config.Load(ctx, configHandle, configStr)
+	if err != nil {
+		g2config.Close(ctx, configHandle)
+		return nil, 0, err
+	}
+	return g2config, configHandle, err
+}
+
+/*
+The GetG2configWithLoad method obtains the G2config object and loads the configuration
+stored under configID, via G2configmgr, into a new configuration handle, so a stored
+configuration can be modified and saved again instead of starting from G2config.Create.
+It is GetG2configForConfigID under the name used by configuration-editing workflows.
+The caller is responsible for calling G2config.Close on the returned handle.
+
+Input
+  - ctx: A context to control lifecycle.
+  - configID: The identifier of a configuration stored in the Senzing repository.
+
+Output
+  - The G2config object.
+  - A configuration handle holding the loaded configuration, for use with the G2config methods.
+*/
+func (factory *SdkAbstractFactoryImpl) GetG2configWithLoad(ctx context.Context, configID int64) (g2api.G2config, uintptr, error) {
+	return factory.GetG2configForConfigID(ctx, configID)
 }
 
 /*
 The GetG2configmgr method returns a G2configmgr object based on the
 information passed in the SdkAbstractFactoryImpl structure.
-If GrpcAddress is spectified, an implementation that communicates over gRPC will be returned.
-If GrpcAddress is empty, an implementation that uses a local Senzing Go SDK will be returned.
+If GrpcAddress is spectified, or a gRPC connection or dialer is provided,
+an implementation that communicates over gRPC will be returned.
+Otherwise, an implementation that uses a local Senzing Go SDK will be returned,
+initialized with ModuleName, EngineConfigurationJson, and VerboseLogging.
 
 Input
   - ctx: A context to control lifecycle.
@@ -116,25 +517,55 @@ Output
     See the example output.
 */
 func (factory *SdkAbstractFactoryImpl) GetG2configmgr(ctx context.Context) (g2api.G2configmgr, error) {
-	var err error = nil
-	factory.g2configmgrSyncOnce.Do(func() {
-		if len(factory.GrpcAddress) > 0 {
-			grpcConnection := factory.getGrpcConnection(ctx)
+	ctx = factory.getContext(ctx)
+	if err := factory.initializeIfPending(ctx, "GetG2configmgr"); err != nil {
+		return nil, err
+	}
+	factory.modeMutex.RLock()
+	defer factory.modeMutex.RUnlock()
+	if err := factory.watchBaseContext(); err != nil {
+		return nil, err
+	}
+	var observerErr error = nil
+	err := factory.g2configmgrSyncOnce.Do(func() error {
+		if factory.NullBackend {
+			factory.g2configmgrSingleton = &nullG2configmgr{}
+		} else if factory.isGrpc() {
+			grpcConnection, err := factory.getGrpcConnection(ctx)
+			if err != nil {
+				return err
+			}
 			factory.g2configmgrSingleton = &g2configmgrgrpc.G2configmgr{
 				GrpcClient: g2configmgrpb.NewG2ConfigMgrClient(grpcConnection),
 			}
 		} else {
-			factory.g2configmgrSingleton = &g2configmgrbase.G2configmgr{}
+			g2configmgr := &g2configmgrbase.G2configmgr{}
+			initParameters := factory.getLocalInitParameters()
+			err := g2configmgr.Init(ctx, initParameters.moduleNameFor("g2configmgr"), initParameters.engineConfigurationJson, initParameters.verboseLogging)
+			if err != nil {
+				factory.getLogger().Log(4002, err)
+				return fmt.Errorf("%w: G2configmgr.Init: %w", ErrNotInitialized, err)
+			}
+			factory.g2configmgrSingleton = g2configmgr
 		}
+		observerErr = factory.addObservedObject(ctx, factory.g2configmgrSingleton)
+		factory.notify(ctx, 8002, observerErr, map[string]string{})
+		factory.recordCreation("G2configmgr")
+		return nil
 	})
-	return factory.g2configmgrSingleton, err
+	if err != nil {
+		return nil, err
+	}
+	return factory.g2configmgrSingleton, observerErr
 }
 
 /*
 The GetG2diagnostic method returns a G2diagnostic object based on the
 information passed in the SdkAbstractFactoryImpl structure.
-If GrpcAddress is spectified, an implementation that communicates over gRPC will be returned.
-If GrpcAddress is empty, an implementation that uses a local Senzing Go SDK will be returned.
+If GrpcAddress is spectified, or a gRPC connection or dialer is provided,
+an implementation that communicates over gRPC will be returned.
+Otherwise, an implementation that uses a local Senzing Go SDK will be returned,
+initialized with ModuleName, EngineConfigurationJson, and VerboseLogging.
 
 Input
   - ctx: A context to control lifecycle.
@@ -144,25 +575,56 @@ Output
     See the example output.
 */
 func (factory *SdkAbstractFactoryImpl) GetG2diagnostic(ctx context.Context) (g2api.G2diagnostic, error) {
-	var err error = nil
-	factory.g2diagnosticSyncOnce.Do(func() {
-		if len(factory.GrpcAddress) > 0 {
-			grpcConnection := factory.getGrpcConnection(ctx)
+	ctx = factory.getContext(ctx)
+	if err := factory.initializeIfPending(ctx, "GetG2diagnostic"); err != nil {
+		return nil, err
+	}
+	factory.modeMutex.RLock()
+	defer factory.modeMutex.RUnlock()
+	if err := factory.watchBaseContext(); err != nil {
+		return nil, err
+	}
+	var observerErr error = nil
+	err := factory.g2diagnosticSyncOnce.Do(func() error {
+		if factory.NullBackend {
+			factory.g2diagnosticSingleton = &nullG2diagnostic{}
+		} else if factory.isGrpc() {
+			grpcConnection, err := factory.getGrpcConnection(ctx)
+			if err != nil {
+				return err
+			}
 			factory.g2diagnosticSingleton = &g2diagnosticgrpc.G2diagnostic{
 				GrpcClient: g2diagnosticpb.NewG2DiagnosticClient(grpcConnection),
 			}
 		} else {
-			factory.g2diagnosticSingleton = &g2diagnosticbase.G2diagnostic{}
+			g2diagnostic := &g2diagnosticbase.G2diagnostic{}
+			initParameters := factory.getLocalInitParameters()
+			err := g2diagnostic.Init(ctx, initParameters.moduleNameFor("g2diagnostic"), initParameters.engineConfigurationJson, initParameters.verboseLogging)
+			if err != nil {
+				factory.getLogger().Log(4003, err)
+				return fmt.Errorf("%w: G2diagnostic.Init: %w", ErrNotInitialized, err)
+			}
+			factory.g2diagnosticSingleton = g2diagnostic
 		}
+		observerErr = factory.addObservedObject(ctx, factory.g2diagnosticSingleton)
+		factory.notify(ctx, 8003, observerErr, map[string]string{})
+		factory.recordCreation("G2diagnostic")
+		return nil
 	})
-	return factory.g2diagnosticSingleton, err
+	if err != nil {
+		return nil, err
+	}
+	return factory.g2diagnosticSingleton, observerErr
 }
 
 /*
 The GetG2engine method returns a G2engine object based on the
 information passed in the SdkAbstractFactoryImpl structure.
-If GrpcAddress is spectified, an implementation that communicates over gRPC will be returned.
-If GrpcAddress is empty, an implementation that uses a local Senzing Go SDK will be returned.
+If GrpcAddress is spectified, or a gRPC connection or dialer is provided,
+an implementation that communicates over gRPC will be returned.
+Otherwise, an implementation that uses a local Senzing Go SDK will be returned,
+initialized with ModuleName, EngineConfigurationJson, and VerboseLogging.
+If GetG2engineWithConfigID created the G2engine, that object is returned.
 
 Input
   - ctx: A context to control lifecycle.
@@ -172,25 +634,121 @@ Output
     See the example output.
 */
 func (factory *SdkAbstractFactoryImpl) GetG2engine(ctx context.Context) (g2api.G2engine, error) {
-	var err error = nil
-	factory.g2engineSyncOnce.Do(func() {
-		if len(factory.GrpcAddress) > 0 {
-			grpcConnection := factory.getGrpcConnection(ctx)
+	ctx = factory.getContext(ctx)
+	if err := factory.initializeIfPending(ctx, "GetG2engine"); err != nil {
+		return nil, err
+	}
+	factory.modeMutex.RLock()
+	defer factory.modeMutex.RUnlock()
+	if err := factory.watchBaseContext(); err != nil {
+		return nil, err
+	}
+	var observerErr error = nil
+	err := factory.g2engineSyncOnce.Do(func() error {
+		if factory.NullBackend {
+			factory.g2engineSingleton = &nullG2engine{}
+		} else if factory.isGrpc() {
+			grpcConnection, err := factory.getGrpcConnection(ctx)
+			if err != nil {
+				return err
+			}
 			factory.g2engineSingleton = &g2enginegrpc.G2engine{
 				GrpcClient: g2enginepb.NewG2EngineClient(grpcConnection),
 			}
 		} else {
-			factory.g2engineSingleton = &g2enginebase.G2engine{}
+			g2engine := &g2enginebase.G2engine{}
+			initParameters := factory.getLocalInitParameters()
+			err := g2engine.Init(ctx, initParameters.moduleNameFor("g2engine"), initParameters.engineConfigurationJson, initParameters.verboseLogging)
+			if err != nil {
+				factory.getLogger().Log(4004, err)
+				return fmt.Errorf("%w: G2engine.Init: %w", ErrNotInitialized, err)
+			}
+			factory.g2engineSingleton = g2engine
 		}
+		observerErr = factory.addObservedObject(ctx, factory.g2engineSingleton)
+		factory.notify(ctx, 8004, observerErr, map[string]string{})
+		factory.recordCreation("G2engine")
+		return nil
 	})
-	return factory.g2engineSingleton, err
+	if err != nil {
+		return nil, err
+	}
+	return factory.g2engineSingleton, observerErr
+}
+
+/*
+The GetG2engineWithConfigID method returns a local G2engine object initialized
+with G2engine.InitWithConfigID, so it uses the configuration stored under configID
+rather than the default configuration.
+The object is the same singleton returned by GetG2engine.  If a G2engine already
+exists with a different configuration, including one created by GetG2engine,
+an error wrapping ErrConflictingConfiguration is returned; call Reset first to
+switch configurations.
+A gRPC server initializes its own G2engine, so in gRPC mode an error wrapping
+ErrUnsupportedMode is returned.  In ModeNull, a no-op G2engine is returned.
+With EagerInitialization, Initialize runs first, as for GetG2engine, so the G2engine it
+creates with the default configuration conflicts with any other configID.
+
+Input
+  - ctx: A context to control lifecycle.
+  - configID: The identifier of a configuration stored in the Senzing repository.
+
+Output
+  - A G2engine object initialized with the configuration identified by configID.
+*/
+func (factory *SdkAbstractFactoryImpl) GetG2engineWithConfigID(ctx context.Context, configID int64) (g2api.G2engine, error) {
+	ctx = factory.getContext(ctx)
+	if err := factory.initializeIfPending(ctx, "GetG2engineWithConfigID"); err != nil {
+		return nil, err
+	}
+	factory.modeMutex.RLock()
+	defer factory.modeMutex.RUnlock()
+	if factory.isGrpc() {
+		return nil, fmt.Errorf("%w: the gRPC server initializes its own G2engine, so GetG2engineWithConfigID requires the local Senzing Go SDK", ErrUnsupportedMode)
+	}
+	if err := factory.watchBaseContext(); err != nil {
+		return nil, err
+	}
+	var observerErr error = nil
+	err := factory.g2engineSyncOnce.Do(func() error {
+		if factory.NullBackend {
+			factory.g2engineSingleton = &nullG2engine{}
+		} else {
+			g2engine := &g2enginebase.G2engine{}
+			initParameters := factory.getLocalInitParameters()
+			err := g2engine.InitWithConfigID(ctx, initParameters.moduleNameFor("g2engine"), initParameters.engineConfigurationJson, configID, initParameters.verboseLogging)
+			if err != nil {
+				factory.getLogger().Log(4004, err)
+				return fmt.Errorf("%w: G2engine.InitWithConfigID: %w", ErrNotInitialized, err)
+			}
+			factory.g2engineSingleton = g2engine
+		}
+		factory.g2engineConfigID = configID
+		observerErr = factory.addObservedObject(ctx, factory.g2engineSingleton)
+		factory.notify(ctx, 8004, observerErr, map[string]string{"configID": strconv.FormatInt(configID, 10)})
+		factory.recordCreation("G2engine")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if factory.g2engineConfigID != configID {
+		existing := "the default configuration"
+		if factory.g2engineConfigID != 0 {
+			existing = fmt.Sprintf("configuration %d", factory.g2engineConfigID)
+		}
+		return nil, fmt.Errorf("%w: G2engine was already initialized with %s, not configuration %d", ErrConflictingConfiguration, existing, configID)
+	}
+	return factory.g2engineSingleton, observerErr
 }
 
 /*
 The GetG2product method returns a G2product object based on the
 information passed in the SdkAbstractFactoryImpl structure.
-If GrpcAddress is spectified, an implementation that communicates over gRPC will be returned.
-If GrpcAddress is empty, an implementation that uses a local Senzing Go SDK will be returned.
+If GrpcAddress is spectified, or a gRPC connection or dialer is provided,
+an implementation that communicates over gRPC will be returned.
+Otherwise, an implementation that uses a local Senzing Go SDK will be returned,
+initialized with ModuleName, EngineConfigurationJson, and VerboseLogging.
 
 Input
   - ctx: A context to control lifecycle.
@@ -200,16 +758,67 @@ Output
     See the example output.
 */
 func (factory *SdkAbstractFactoryImpl) GetG2product(ctx context.Context) (g2api.G2product, error) {
-	var err error = nil
-	factory.g2productSyncOnce.Do(func() {
-		if len(factory.GrpcAddress) > 0 {
-			grpcConnection := factory.getGrpcConnection(ctx)
+	ctx = factory.getContext(ctx)
+	if err := factory.initializeIfPending(ctx, "GetG2product"); err != nil {
+		return nil, err
+	}
+	factory.modeMutex.RLock()
+	defer factory.modeMutex.RUnlock()
+	if err := factory.watchBaseContext(); err != nil {
+		return nil, err
+	}
+	var observerErr error = nil
+	err := factory.g2productSyncOnce.Do(func() error {
+		if factory.NullBackend {
+			factory.g2productSingleton = &nullG2product{}
+		} else if factory.isGrpc() {
+			grpcConnection, err := factory.getGrpcConnection(ctx)
+			if err != nil {
+				return err
+			}
 			factory.g2productSingleton = &g2productgrpc.G2product{
 				GrpcClient: g2productpb.NewG2ProductClient(grpcConnection),
 			}
 		} else {
-			factory.g2productSingleton = &g2productbase.G2product{}
+			g2product := &g2productbase.G2product{}
+			initParameters := factory.getLocalInitParameters()
+			err := g2product.Init(ctx, initParameters.moduleNameFor("g2product"), initParameters.engineConfigurationJson, initParameters.verboseLogging)
+			if err != nil {
+				factory.getLogger().Log(4005, err)
+				return fmt.Errorf("%w: G2product.Init: %w", ErrNotInitialized, err)
+			}
+			factory.g2productSingleton = g2product
 		}
+		observerErr = factory.addObservedObject(ctx, factory.g2productSingleton)
+		factory.notify(ctx, 8005, observerErr, map[string]string{})
+		factory.recordCreation("G2product")
+		return nil
 	})
-	return factory.g2productSingleton, err
+	if err != nil {
+		return nil, err
+	}
+	return factory.g2productSingleton, observerErr
+}
+
+/*
+The Reset method returns the factory to its initial state so that subsequent GetG2*
+calls create new objects from the current field values, e.g. after changing GrpcAddress.
+With destroy, the existing objects are first released as described for Destroy.
+Without it, they are only forgotten: objects already returned to callers, and the gRPC
+connection they share, remain usable and become the callers' responsibility.
+GetG2* calls made during Reset wait for it to finish; otherwise, Reset must not be
+called concurrently with other factory methods.
+
+Input
+  - ctx: A context to control lifecycle.
+  - destroy: Whether to destroy the existing objects first.
+
+Output
+  - With destroy, all errors encountered, joined with errors.Join.
+*/
+func (factory *SdkAbstractFactoryImpl) Reset(ctx context.Context, destroy bool) error {
+	ctx = factory.getContext(ctx)
+	factory.modeMutex.Lock()
+	defer factory.modeMutex.Unlock()
+	return factory.resetObjects(ctx, destroy)
 }
@@ -3,15 +3,24 @@ package factory
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
 	"os"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	truncator "github.com/aquilax/truncate"
+	g2enginegrpc "github.com/senzing/g2-sdk-go-grpc/g2engine"
+	"github.com/senzing/g2-sdk-go/g2api"
 	"github.com/senzing/go-common/g2engineconfigurationjson"
+	"github.com/senzing/go-logging/messagelogger"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 const (
@@ -21,23 +30,78 @@ const (
 var (
 	iniParams                        string
 	moduleName                       string = "Test module name"
-	sdkAbstractFactoryGrpcSingleton  SdkAbstractFactory
-	sdkAbstractFactoryLocalSingleton SdkAbstractFactory
+	sdkAbstractFactoryGrpcSingleton  ManagedSdkAbstractFactory
+	sdkAbstractFactoryLocalSingleton ManagedSdkAbstractFactory
 	verboseLogging                   int = 0
 )
 
+// ----------------------------------------------------------------------------
+// Test doubles
+// ----------------------------------------------------------------------------
+
+type destroyG2engine struct {
+	g2api.G2engine
+	destroyCount int
+}
+
+func (g2engine *destroyG2engine) Destroy(ctx context.Context) error {
+	g2engine.destroyCount++
+	return nil
+}
+
+type recordingLogger struct {
+	messagelogger.MessageLoggerInterface
+	details        [][]interface{}
+	messageNumbers []int
+	messages       []string
+}
+
+func (logger *recordingLogger) Log(messageNumber int, details ...interface{}) error {
+	logger.details = append(logger.details, details)
+	logger.messageNumbers = append(logger.messageNumbers, messageNumber)
+	logger.messages = append(logger.messages, fmt.Sprintf(IdMessages[messageNumber], details...))
+	return nil
+}
+
+// hookLogger calls hook for every message logged, one message at a time.
+type hookLogger struct {
+	messagelogger.MessageLoggerInterface
+	hook  func(messageNumber int)
+	mutex sync.Mutex
+}
+
+func (logger *hookLogger) Log(messageNumber int, details ...interface{}) error {
+	logger.mutex.Lock()
+	defer logger.mutex.Unlock()
+	logger.hook(messageNumber)
+	return nil
+}
+
+type activeConfigG2engine struct {
+	g2api.G2engine
+	activeConfigID int64
+}
+
+func (g2engine *activeConfigG2engine) GetActiveConfigID(ctx context.Context) (int64, error) {
+	return g2engine.activeConfigID, nil
+}
+
 // ----------------------------------------------------------------------------
 // Internal functions
 // ----------------------------------------------------------------------------
 
-func getTestObjectLocal(ctx context.Context, test *testing.T) SdkAbstractFactory {
+func getTestObjectLocal(ctx context.Context, test *testing.T) ManagedSdkAbstractFactory {
 	if sdkAbstractFactoryLocalSingleton == nil {
-		sdkAbstractFactoryLocalSingleton = &SdkAbstractFactoryImpl{}
+		sdkAbstractFactoryLocalSingleton = &SdkAbstractFactoryImpl{
+			EngineConfigurationJson: iniParams,
+			ModuleName:              moduleName,
+			VerboseLogging:          verboseLogging,
+		}
 	}
 	return sdkAbstractFactoryLocalSingleton
 }
 
-func getTestObjectGrpc(ctx context.Context, test *testing.T) SdkAbstractFactory {
+func getTestObjectGrpc(ctx context.Context, test *testing.T) ManagedSdkAbstractFactory {
 	if sdkAbstractFactoryGrpcSingleton == nil {
 		sdkAbstractFactoryGrpcSingleton = &SdkAbstractFactoryImpl{
 			GrpcAddress: "localhost:8258",
@@ -46,6 +110,26 @@ func getTestObjectGrpc(ctx context.Context, test *testing.T) SdkAbstractFactory
 	return sdkAbstractFactoryGrpcSingleton
 }
 
+func getTestObjectWithG2engine(g2engine g2api.G2engine) *SdkAbstractFactoryImpl {
+	result := &SdkAbstractFactoryImpl{}
+	result.g2engineSyncOnce.Do(func() error {
+		result.g2engineSingleton = g2engine
+		result.addObservedObject(context.TODO(), g2engine)
+		return nil
+	})
+	return result
+}
+
+func getTestObjectWithG2product(g2product g2api.G2product) *SdkAbstractFactoryImpl {
+	result := &SdkAbstractFactoryImpl{}
+	result.g2productSyncOnce.Do(func() error {
+		result.g2productSingleton = g2product
+		result.addObservedObject(context.TODO(), g2product)
+		return nil
+	})
+	return result
+}
+
 func truncate(aString string, length int) string {
 	return truncator.Truncate(aString, length, "...", truncator.PositionEnd)
 }
@@ -171,6 +255,49 @@ func helperSdkAbstractFactoryImpl_GetG2configmgr(test *testing.T, ctx context.Co
 	testError(test, ctx, err)
 }
 
+func helperSdkAbstractFactoryImpl_GetG2configForConfigID(test *testing.T, ctx context.Context, testObject ManagedSdkAbstractFactory) {
+	helperSdkAbstractFactoryImpl_loadConfig(test, ctx, testObject, testObject.GetG2configForConfigID)
+}
+
+func helperSdkAbstractFactoryImpl_GetG2configWithLoad(test *testing.T, ctx context.Context, testObject ManagedSdkAbstractFactory) {
+	helperSdkAbstractFactoryImpl_loadConfig(test, ctx, testObject, testObject.GetG2configWithLoad)
+}
+
+// Persist a configuration with an additional data source, then load it back with load.
+func helperSdkAbstractFactoryImpl_loadConfig(test *testing.T, ctx context.Context, testObject ManagedSdkAbstractFactory, load func(ctx context.Context, configID int64) (g2api.G2config, uintptr, error)) {
+	helperSdkAbstractFactoryImpl_GetG2configmgr(test, ctx, testObject)
+	g2configmgr, err := testObject.GetG2configmgr(ctx)
+	testError(test, ctx, err)
+
+	// Persist a configuration with an additional data source.
+
+	g2config, err := testObject.GetG2config(ctx)
+	testError(test, ctx, err)
+	configHandle, err := g2config.Create(ctx)
+	testError(test, ctx, err)
+	_, err = g2config.AddDataSource(ctx, configHandle, `{"DSRC_CODE": "LOADED_CONFIG"}`)
+	testError(test, ctx, err)
+	expected, err := g2config.ListDataSources(ctx, configHandle)
+	testError(test, ctx, err)
+	configStr, err := g2config.Save(ctx, configHandle)
+	testError(test, ctx, err)
+	err = g2config.Close(ctx, configHandle)
+	testError(test, ctx, err)
+	configID, err := g2configmgr.AddConfig(ctx, configStr, "Created by helperSdkAbstractFactoryImpl_loadConfig")
+	testError(test, ctx, err)
+
+	// Load it back.
+
+	g2config, configHandle, err = load(ctx, configID)
+	testError(test, ctx, err)
+	actual, err := g2config.ListDataSources(ctx, configHandle)
+	testError(test, ctx, err)
+	printActual(test, actual)
+	assert.JSONEq(test, expected, actual)
+	err = g2config.Close(ctx, configHandle)
+	testError(test, ctx, err)
+}
+
 func helperSdkAbstractFactoryImpl_GetG2diagnostic(test *testing.T, ctx context.Context, testObject SdkAbstractFactory) {
 	g2diagnostic, err := testObject.GetG2diagnostic(ctx)
 	testError(test, ctx, err)
@@ -235,6 +362,30 @@ func TestSdkAbstractFactoryImpl_GetG2configmgr_gRPC(test *testing.T) {
 	helperSdkAbstractFactoryImpl_GetG2configmgr(test, ctx, testObject)
 }
 
+func TestSdkAbstractFactoryImpl_GetG2configForConfigID_local(test *testing.T) {
+	ctx := context.TODO()
+	testObject := getTestObjectLocal(ctx, test)
+	helperSdkAbstractFactoryImpl_GetG2configForConfigID(test, ctx, testObject)
+}
+
+func TestSdkAbstractFactoryImpl_GetG2configForConfigID_gRPC(test *testing.T) {
+	ctx := context.TODO()
+	testObject := getTestObjectGrpc(ctx, test)
+	helperSdkAbstractFactoryImpl_GetG2configForConfigID(test, ctx, testObject)
+}
+
+func TestSdkAbstractFactoryImpl_GetG2configWithLoad_local(test *testing.T) {
+	ctx := context.TODO()
+	testObject := getTestObjectLocal(ctx, test)
+	helperSdkAbstractFactoryImpl_GetG2configWithLoad(test, ctx, testObject)
+}
+
+func TestSdkAbstractFactoryImpl_GetG2configWithLoad_gRPC(test *testing.T) {
+	ctx := context.TODO()
+	testObject := getTestObjectGrpc(ctx, test)
+	helperSdkAbstractFactoryImpl_GetG2configWithLoad(test, ctx, testObject)
+}
+
 func TestSdkAbstractFactoryImpl_GetG2diagnostic_local(test *testing.T) {
 	ctx := context.TODO()
 	testObject := getTestObjectLocal(ctx, test)
@@ -270,3 +421,429 @@ func TestSdkAbstractFactoryImpl_GetG2product_gRPC(test *testing.T) {
 	testObject := getTestObjectGrpc(ctx, test)
 	helperSdkAbstractFactoryImpl_GetG2product(test, ctx, testObject)
 }
+
+func TestSdkAbstractFactoryImpl_ActiveConfigID(test *testing.T) {
+	ctx := context.TODO()
+	testObject := getTestObjectWithG2engine(&activeConfigG2engine{activeConfigID: 4015588140})
+	actual, err := testObject.ActiveConfigID(ctx)
+	testError(test, ctx, err)
+	assert.Equal(test, int64(4015588140), actual)
+}
+
+func TestSdkAbstractFactoryImpl_CreatedObjects(test *testing.T) {
+	ctx := context.TODO()
+	assert.Empty(test, (&SdkAbstractFactoryImpl{}).CreatedObjects(ctx))
+	g2engine := &debugG2engine{}
+	testObject := getTestObjectWithG2engine(g2engine)
+	assert.Equal(test, []interface{}{g2engine}, testObject.CreatedObjects(ctx))
+}
+
+func TestSdkAbstractFactoryImpl_CreatedObjects_concurrentReset(test *testing.T) {
+	ctx := context.TODO()
+	testObject := &SdkAbstractFactoryImpl{NullBackend: true}
+	var waitGroup sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		waitGroup.Add(3)
+		go func() {
+			defer waitGroup.Done()
+			for j := 0; j < 100; j++ {
+				_, err := testObject.GetG2engine(ctx)
+				assert.NoError(test, err)
+				assert.LessOrEqual(test, len(testObject.CreatedObjects(ctx)), 1)
+			}
+		}()
+		go func() {
+			defer waitGroup.Done()
+			for j := 0; j < 100; j++ {
+				assert.LessOrEqual(test, len(testObject.CreatedObjects(ctx)), 1)
+			}
+		}()
+		go func() {
+			defer waitGroup.Done()
+			for j := 0; j < 100; j++ {
+				assert.NoError(test, testObject.Reset(ctx, j%2 == 0))
+			}
+		}()
+	}
+	waitGroup.Wait()
+}
+
+func TestSdkAbstractFactoryImpl_GetG2engineWithConfigID_local(test *testing.T) {
+	ctx := context.TODO()
+	configID, err := getTestObjectLocal(ctx, test).ActiveConfigID(ctx)
+	testError(test, ctx, err)
+	testObject := &SdkAbstractFactoryImpl{
+		EngineConfigurationJson: iniParams,
+		ModuleName:              moduleName,
+		VerboseLogging:          verboseLogging,
+	}
+	defer testObject.Destroy(ctx)
+	g2engine, err := testObject.GetG2engineWithConfigID(ctx, configID)
+	testError(test, ctx, err)
+	again, err := testObject.GetG2engineWithConfigID(ctx, configID)
+	testError(test, ctx, err)
+	assert.Same(test, g2engine, again)
+	fromGetG2engine, err := testObject.GetG2engine(ctx)
+	testError(test, ctx, err)
+	assert.Same(test, g2engine, fromGetG2engine)
+	_, err = testObject.GetG2engineWithConfigID(ctx, configID+1)
+	assert.ErrorIs(test, err, ErrConflictingConfiguration)
+}
+
+func TestSdkAbstractFactoryImpl_GetG2engineWithConfigID_gRPC(test *testing.T) {
+	ctx := context.TODO()
+	testObject := &SdkAbstractFactoryImpl{
+		GrpcAddress: "localhost:8258",
+	}
+	_, err := testObject.GetG2engineWithConfigID(ctx, 4015588140)
+	assert.ErrorIs(test, err, ErrUnsupportedMode)
+	assert.Empty(test, testObject.CreatedObjects(ctx))
+}
+
+func TestSdkAbstractFactoryImpl_GetG2engineWithConfigID_defaultEngineExists(test *testing.T) {
+	ctx := context.TODO()
+	testObject := getTestObjectWithG2engine(&destroyG2engine{})
+	_, err := testObject.GetG2engineWithConfigID(ctx, 4015588140)
+	assert.ErrorIs(test, err, ErrConflictingConfiguration)
+	assert.ErrorContains(test, err, "the default configuration")
+}
+
+func TestSdkAbstractFactoryImpl_GetG2product_logBackend_local(test *testing.T) {
+	ctx := context.TODO()
+	logger := &recordingLogger{}
+	testObject := &SdkAbstractFactoryImpl{
+		EngineConfigurationJson: iniParams,
+		logger:                  logger,
+		ModuleName:              moduleName,
+		VerboseLogging:          verboseLogging,
+	}
+	_, err := testObject.GetG2product(ctx)
+	testError(test, ctx, err)
+	_, err = testObject.GetG2product(ctx)
+	testError(test, ctx, err)
+	assert.Equal(test, []string{"Created G2product using the local Senzing Go SDK"}, logger.messages)
+	testError(test, ctx, testObject.Destroy(ctx))
+}
+
+func TestSdkAbstractFactoryImpl_GetG2product_logBackend_gRPC(test *testing.T) {
+	ctx := context.TODO()
+	logger := &recordingLogger{}
+	testObject := &SdkAbstractFactoryImpl{
+		GrpcAddress: "localhost:8258",
+		logger:      logger,
+	}
+	_, err := testObject.GetG2product(ctx)
+	testError(test, ctx, err)
+	_, err = testObject.GetG2product(ctx)
+	testError(test, ctx, err)
+	assert.Equal(test, []string{"Created G2product using the Senzing gRPC server at localhost:8258"}, logger.messages)
+	testError(test, ctx, testObject.Destroy(ctx))
+}
+
+func TestSdkAbstractFactoryImpl_messageIdsReserved(test *testing.T) {
+	logger := &recordingLogger{}
+	testObject := &SdkAbstractFactoryImpl{
+		logger:      logger,
+		NullBackend: true,
+	}
+	_, err := testObject.GetAll(nil)
+	testError(test, context.TODO(), err)
+	assert.NotEmpty(test, logger.messageNumbers)
+	assert.Equal(test, fmt.Sprintf("senzing-%04d%%04d", ProductId), MessageIdTemplate)
+	for _, messageNumber := range logger.messageNumbers {
+		assert.Contains(test, IdMessages, messageNumber)
+		assert.Regexp(test, `^senzing-6041\d{4}$`, fmt.Sprintf(MessageIdTemplate, messageNumber))
+	}
+	for messageNumber := range IdMessages {
+		assert.True(test, messageNumber >= 0 && messageNumber <= MaxMessageNumber, "message number %d is outside the reserved range", messageNumber)
+	}
+}
+
+func TestSdkAbstractFactoryImpl_concurrentUse(test *testing.T) {
+	const goroutines = 100
+	testCases := []struct {
+		name       string
+		testObject *SdkAbstractFactoryImpl
+	}{
+		{name: "gRPC", testObject: &SdkAbstractFactoryImpl{GrpcAddress: startTestGrpcServer(test)}},
+		{name: "null", testObject: &SdkAbstractFactoryImpl{NullBackend: true}},
+	}
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func(test *testing.T) {
+			ctx := context.TODO()
+			testObject := testCase.testObject
+			defer testObject.Destroy(ctx)
+			var waitGroup sync.WaitGroup
+			for index := 0; index < goroutines; index++ {
+				waitGroup.Add(1)
+				go func() {
+					defer waitGroup.Done()
+					assert.NoError(test, testObject.RegisterObserver(ctx, &recordingObserver{}))
+					_, err := testObject.GetG2config(ctx)
+					assert.NoError(test, err)
+					_, err = testObject.GetG2configmgr(ctx)
+					assert.NoError(test, err)
+					_, err = testObject.GetG2diagnostic(ctx)
+					assert.NoError(test, err)
+					_, err = testObject.GetG2engine(ctx)
+					assert.NoError(test, err)
+					_, err = testObject.GetG2product(ctx)
+					assert.NoError(test, err)
+					_, err = testObject.GetAll(ctx)
+					assert.NoError(test, err)
+					testObject.CreatedObjects(ctx)
+				}()
+			}
+			waitGroup.Wait()
+			assert.Len(test, testObject.CreatedObjects(ctx), 5)
+			assert.Len(test, testObject.observers, goroutines)
+		})
+	}
+}
+
+func TestSdkAbstractFactoryImpl_GetG2engine_nilContext(test *testing.T) {
+	testObject := &SdkAbstractFactoryImpl{
+		GrpcAddress: "localhost:8258",
+	}
+	assert.NotPanics(test, func() {
+		_, err := testObject.GetG2engine(nil)
+		assert.NoError(test, err)
+	})
+}
+
+func TestSdkAbstractFactoryImpl_Destroy(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &destroyG2engine{}
+	testObject := getTestObjectWithG2engine(g2engine)
+	err := testObject.Destroy(ctx)
+	testError(test, ctx, err)
+	assert.Equal(test, 1, g2engine.destroyCount)
+	assert.Empty(test, testObject.CreatedObjects(ctx))
+}
+
+func TestSdkAbstractFactoryImpl_Destroy_gRPC(test *testing.T) {
+	ctx := context.TODO()
+	testObject := &SdkAbstractFactoryImpl{
+		GrpcAddress: "localhost:8258",
+	}
+	g2engine, err := testObject.GetG2engine(ctx)
+	testError(test, ctx, err)
+	err = testObject.Destroy(ctx)
+	testError(test, ctx, err)
+	assert.Empty(test, testObject.CreatedObjects(ctx))
+	recreated, err := testObject.GetG2engine(ctx)
+	testError(test, ctx, err)
+	assert.NotSame(test, g2engine, recreated)
+	testError(test, ctx, testObject.Destroy(ctx))
+}
+
+func TestSdkAbstractFactoryImpl_Reset(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &destroyG2engine{}
+	testObject := getTestObjectWithG2engine(g2engine)
+	testObject.GrpcAddress = "localhost:8258"
+	err := testObject.Reset(ctx, true)
+	testError(test, ctx, err)
+	assert.Equal(test, 1, g2engine.destroyCount)
+	recreated, err := testObject.GetG2engine(ctx)
+	testError(test, ctx, err)
+	assert.IsType(test, &g2enginegrpc.G2engine{}, recreated)
+	testError(test, ctx, testObject.Destroy(ctx))
+}
+
+func TestSdkAbstractFactoryImpl_Reset_concurrentGetters(test *testing.T) {
+	ctx := context.TODO()
+	metrics := &recordingMetrics{}
+	testObject := &SdkAbstractFactoryImpl{Metrics: metrics, NullBackend: true}
+
+	// While GetG2engine creates the G2engine, start a Reset that waits for the write lock.
+	resetErr := make(chan error, 1)
+	testObject.logger = &hookLogger{hook: func(messageNumber int) {
+		if messageNumber != 2003 {
+			return
+		}
+		go func() { resetErr <- testObject.Reset(ctx, true) }()
+		time.Sleep(50 * time.Millisecond)
+	}}
+	getterErr := make(chan error, 1)
+	go func() {
+		_, err := testObject.GetG2engine(ctx)
+		getterErr <- err
+	}()
+	for _, result := range []chan error{getterErr, resetErr} {
+		select {
+		case err := <-result:
+			testError(test, ctx, err)
+		case <-time.After(10 * time.Second):
+			test.Fatal("GetG2engine and Reset deadlocked")
+		}
+	}
+	assert.Equal(test, 1, metrics.count("created", "G2engine", "null"))
+}
+
+func TestSdkAbstractFactoryImpl_Reset_noDestroy(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &destroyG2engine{}
+	testObject := getTestObjectWithG2engine(g2engine)
+	err := testObject.Reset(ctx, false)
+	testError(test, ctx, err)
+	assert.Equal(test, 0, g2engine.destroyCount)
+	assert.Empty(test, testObject.CreatedObjects(ctx))
+}
+
+func TestSdkAbstractFactoryImpl_getGrpcConnection(test *testing.T) {
+	ctx := context.TODO()
+	testObject := &SdkAbstractFactoryImpl{
+		GrpcAddress: "localhost:8258",
+	}
+	grpcConnection, err := testObject.getGrpcConnection(ctx)
+	testError(test, ctx, err)
+	assert.NotNil(test, grpcConnection)
+	sameConnection, err := testObject.getGrpcConnection(ctx)
+	testError(test, ctx, err)
+	assert.Same(test, grpcConnection, sameConnection)
+	testError(test, ctx, testObject.Destroy(ctx))
+	newConnection, err := testObject.getGrpcConnection(ctx)
+	testError(test, ctx, err)
+	assert.NotSame(test, grpcConnection, newConnection)
+	testError(test, ctx, testObject.Destroy(ctx))
+}
+
+func TestSdkAbstractFactoryImpl_getGrpcConnection_shared(test *testing.T) {
+	ctx := context.TODO()
+	grpcConnection, err := grpc.Dial(startTestGrpcServer(test), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	testError(test, ctx, err)
+	defer grpcConnection.Close()
+	testObject, err := New(WithGrpcConnection(grpcConnection))
+	testError(test, ctx, err)
+	g2engine, err := testObject.GetG2engine(ctx)
+	testError(test, ctx, err)
+	assert.IsType(test, &g2enginegrpc.G2engine{}, g2engine)
+	factoryConnection, err := testObject.GrpcConnection(ctx)
+	testError(test, ctx, err)
+	assert.Same(test, grpcConnection, factoryConnection)
+	testError(test, ctx, testObject.Destroy(ctx))
+	assert.NotEqual(test, connectivity.Shutdown, grpcConnection.GetState(), "Destroy must not close a shared connection")
+}
+
+func TestSdkAbstractFactoryImpl_getGrpcConnection_dialer(test *testing.T) {
+	ctx := context.TODO()
+	grpcAddress := startTestGrpcServer(test)
+	dialCount := 0
+	var grpcConnection *grpc.ClientConn
+	dialer := func(ctx context.Context) (*grpc.ClientConn, error) {
+		dialCount++
+		var err error
+		grpcConnection, err = grpc.DialContext(ctx, grpcAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		return grpcConnection, err
+	}
+	testObject, err := New(WithGrpcDialer(dialer))
+	testError(test, ctx, err)
+	_, err = testObject.GetG2engine(ctx)
+	testError(test, ctx, err)
+	_, err = testObject.GetG2product(ctx)
+	testError(test, ctx, err)
+	assert.Equal(test, 1, dialCount)
+	testError(test, ctx, testObject.Destroy(ctx))
+	assert.Equal(test, connectivity.Shutdown, grpcConnection.GetState())
+}
+
+func TestSdkAbstractFactoryImpl_getGrpcConnection_retry(test *testing.T) {
+	ctx := context.TODO()
+	grpcAddress := startTestGrpcServer(test)
+	dialCount := 0
+	dialer := func(ctx context.Context) (*grpc.ClientConn, error) {
+		dialCount++
+		if dialCount < 3 {
+			return nil, errors.New("server restarting")
+		}
+		return grpc.DialContext(ctx, grpcAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+	testObject, err := New(WithGrpcDialer(dialer), WithRetry(3, time.Millisecond))
+	testError(test, ctx, err)
+	defer testObject.Destroy(ctx)
+	g2engine, err := testObject.GetG2engine(ctx)
+	testError(test, ctx, err)
+	assert.IsType(test, &g2enginegrpc.G2engine{}, g2engine)
+	assert.Equal(test, 3, dialCount)
+	testError(test, ctx, checkTestGrpcConnection(ctx, testObject))
+}
+
+func TestSdkAbstractFactoryImpl_getGrpcConnection_retryCancelled(test *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	dialCount := 0
+	dialer := func(ctx context.Context) (*grpc.ClientConn, error) {
+		dialCount++
+		cancel()
+		return nil, errors.New("server restarting")
+	}
+	testObject, err := New(WithGrpcDialer(dialer), WithRetry(5, time.Hour))
+	testError(test, ctx, err)
+	_, err = testObject.GetG2engine(ctx)
+	assert.ErrorIs(test, err, context.Canceled)
+	assert.Equal(test, 1, dialCount)
+}
+
+func TestSdkAbstractFactoryImpl_GetG2engine_unreachable(test *testing.T) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 100*time.Millisecond)
+	defer cancel()
+	testObject := &SdkAbstractFactoryImpl{
+		GrpcAddress: "localhost:1",
+		GrpcOptions: []grpc.DialOption{
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithBlock(),
+		},
+	}
+	g2engine, err := testObject.GetG2engine(ctx)
+	assert.Error(test, err)
+	assert.Nil(test, g2engine)
+}
+
+func TestSdkAbstractFactoryImpl_GetG2engine_dialTimeout(test *testing.T) {
+	ctx := context.TODO()
+	timeout := 200 * time.Millisecond
+	testObject := &SdkAbstractFactoryImpl{
+		GrpcAddress:     "10.255.255.1:8258",
+		GrpcDialTimeout: &timeout,
+		GrpcOptions: []grpc.DialOption{
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithBlock(),
+		},
+	}
+	start := time.Now()
+	g2engine, err := testObject.GetG2engine(ctx)
+	assert.ErrorIs(test, err, context.DeadlineExceeded)
+	assert.Nil(test, g2engine)
+	assert.Less(test, time.Since(start), 5*timeout)
+}
+
+func TestSdkAbstractFactoryImpl_GetG2engine_retryAfterDialError(test *testing.T) {
+	ctx := context.TODO()
+	listener, err := net.Listen("tcp", "localhost:0")
+	testError(test, ctx, err)
+	grpcAddress := listener.Addr().String()
+	testError(test, ctx, listener.Close())
+
+	timeout := 200 * time.Millisecond
+	testObject := &SdkAbstractFactoryImpl{
+		GrpcAddress:     grpcAddress,
+		GrpcDialTimeout: &timeout,
+		GrpcOptions: []grpc.DialOption{
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithBlock(),
+		},
+	}
+	g2engine, err := testObject.GetG2engine(ctx)
+	assert.Error(test, err)
+	assert.Nil(test, g2engine)
+
+	listener, err = net.Listen("tcp", grpcAddress)
+	testError(test, ctx, err)
+	server := grpc.NewServer()
+	go server.Serve(listener)
+	defer server.Stop()
+	g2engine, err = testObject.GetG2engine(ctx)
+	testError(test, ctx, err)
+	assert.IsType(test, &g2enginegrpc.G2engine{}, g2engine)
+	testError(test, ctx, testObject.Destroy(ctx))
+}
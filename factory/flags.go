@@ -0,0 +1,78 @@
+package factory
+
+import "github.com/senzing/g2-sdk-go/g2api"
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Flags is a bit mask of Senzing engine flags accepted by the factory's convenience methods.
+// It wraps g2api.FlagMask, adding bits above the engine flags that only the factory interprets.
+type Flags g2api.FlagMask
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Flags controlling which entities G2engine.ExportJSONEntityReport includes.
+// Values are the g2api G2_EXPORT_INCLUDE_* flags; note that resolved entities are always included.
+const (
+	FlagsNone                          Flags = 0
+	FlagsExportIncludeResolved         Flags = Flags(g2api.G2_EXPORT_INCLUDE_RESOLVED)
+	FlagsExportIncludePossiblySame     Flags = Flags(g2api.G2_EXPORT_INCLUDE_POSSIBLY_SAME)
+	FlagsExportIncludePossiblyRelated  Flags = Flags(g2api.G2_EXPORT_INCLUDE_POSSIBLY_RELATED)
+	FlagsExportIncludeNameOnly         Flags = Flags(g2api.G2_EXPORT_INCLUDE_NAME_ONLY)
+	FlagsExportIncludeDisclosed        Flags = Flags(g2api.G2_EXPORT_INCLUDE_DISCLOSED)
+	FlagsExportIncludeSingletons       Flags = Flags(g2api.G2_EXPORT_INCLUDE_SINGLETONS)
+	FlagsExportIncludeAllEntities      Flags = Flags(g2api.G2_EXPORT_INCLUDE_ALL_ENTITIES)
+	FlagsExportIncludeAllRelationships Flags = Flags(g2api.G2_EXPORT_INCLUDE_ALL_RELATIONSHIPS)
+)
+
+// Flags interpreted by the factory's convenience methods and never passed to the engine.
+const (
+	FlagsWithoutInfo Flags = 1 << 60 // Use the engine's variant without WithInfo, where WithInfo is the default.
+	FlagsDefault     Flags = 1 << 61 // Use the flags set by WithDefaultEngineFlags.
+	FlagsWithInfo    Flags = 1 << 62 // Use the engine's WithInfo variant and return its result.
+
+	factoryFlags = FlagsWithoutInfo | FlagsDefault | FlagsWithInfo
+)
+
+// ----------------------------------------------------------------------------
+// Functions
+// ----------------------------------------------------------------------------
+
+// Combine returns the bitwise OR of all the given flags.
+func Combine(flags ...Flags) Flags {
+	result := FlagsNone
+	for _, flag := range flags {
+		result |= flag
+	}
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+// Has reports whether every bit in flag is set.
+func (flags Flags) Has(flag Flags) bool {
+	return flags&flag == flag
+}
+
+// Int64 returns the raw value expected by the g2api methods, without the flags only the factory interprets.
+func (flags Flags) Int64() int64 {
+	return int64(flags &^ factoryFlags)
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Replace FlagsDefault with DefaultEngineFlags, keeping any other flags.
+// FlagsNone stays as is: it asks for no engine flags.
+func (factory *SdkAbstractFactoryImpl) engineFlags(flags Flags) Flags {
+	if flags.Has(FlagsDefault) {
+		return flags&^FlagsDefault | factory.DefaultEngineFlags
+	}
+	return flags
+}
@@ -0,0 +1,38 @@
+package factory
+
+import (
+	"testing"
+
+	"github.com/senzing/g2-sdk-go/g2api"
+	"github.com/stretchr/testify/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestCombine(test *testing.T) {
+	actual := Combine(FlagsExportIncludeResolved, FlagsExportIncludeSingletons)
+	assert.Equal(test, FlagsExportIncludeAllEntities, actual)
+	assert.Equal(test, int64(g2api.G2_EXPORT_INCLUDE_ALL_ENTITIES), actual.Int64())
+	assert.Equal(test, int64(32), actual.Int64())
+	assert.True(test, actual.Has(FlagsExportIncludeSingletons))
+	assert.False(test, actual.Has(FlagsExportIncludeDisclosed))
+	assert.Equal(test, FlagsNone, Combine())
+}
+
+func TestFlags_Int64(test *testing.T) {
+	flags := Combine(FlagsDefault, FlagsWithInfo, FlagsExportIncludeAllRelationships)
+	assert.True(test, flags.Has(FlagsWithInfo))
+	assert.Equal(test, int64(g2api.G2_EXPORT_INCLUDE_ALL_RELATIONSHIPS), flags.Int64())
+	assert.Equal(test, int64(0), FlagsWithInfo.Int64())
+	assert.Equal(test, int64(0), FlagsWithoutInfo.Int64())
+}
+
+func TestSdkAbstractFactoryImpl_engineFlags(test *testing.T) {
+	testObject := &SdkAbstractFactoryImpl{DefaultEngineFlags: FlagsExportIncludeAllEntities}
+	assert.Equal(test, FlagsNone, testObject.engineFlags(FlagsNone))
+	assert.Equal(test, FlagsExportIncludeDisclosed, testObject.engineFlags(FlagsExportIncludeDisclosed))
+	assert.Equal(test, FlagsExportIncludeAllEntities, testObject.engineFlags(FlagsDefault))
+	assert.Equal(test, Combine(FlagsExportIncludeAllEntities, FlagsWithInfo), testObject.engineFlags(Combine(FlagsDefault, FlagsWithInfo)))
+}
@@ -0,0 +1,339 @@
+package factory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	_ "google.golang.org/grpc/encoding/gzip" // Registers the "gzip" compressor for WithCompression.
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
+	"google.golang.org/grpc/status"
+)
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Timeout for establishing the gRPC connection when GrpcDialTimeout is nil.
+const defaultGrpcDialTimeout = 30 * time.Second
+
+// Resolver scheme for a comma-separated GrpcAddress.
+const grpcAddressListScheme = "senzing-address-list"
+
+// Minimum time allowed for a connection attempt when GrpcConnectBackoff is set; gRPC's own default.
+const grpcMinConnectTimeout = 20 * time.Second
+
+// ----------------------------------------------------------------------------
+// Functions
+// ----------------------------------------------------------------------------
+
+/*
+The GrpcDialOptionsFromEnv function translates SENZING_GRPC_* environment variables into gRPC dial options.
+Unset variables are ignored.
+
+	SENZING_GRPC_MAX_RECV_MSG_SIZE                   Maximum message size, in bytes, the client can receive.
+	SENZING_GRPC_MAX_SEND_MSG_SIZE                   Maximum message size, in bytes, the client can send.
+	SENZING_GRPC_KEEPALIVE_TIME                      Ping the server after this much inactivity. Example: "30s".
+	SENZING_GRPC_KEEPALIVE_TIMEOUT                   Close the connection if a ping is not acknowledged in this time. Example: "10s".
+	SENZING_GRPC_KEEPALIVE_PERMIT_WITHOUT_STREAM     Send pings even without active RPCs. Example: "true".
+
+Output
+  - The dial options for every variable that parsed successfully.
+  - An error describing each variable that could not be parsed.
+*/
+func GrpcDialOptionsFromEnv() ([]grpc.DialOption, error) {
+	var errs []error
+	result := []grpc.DialOption{}
+
+	parseInt := func(name string) (int, bool) {
+		value, isSet := os.LookupEnv(name)
+		if !isSet {
+			return 0, false
+		}
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed <= 0 {
+			errs = append(errs, fmt.Errorf("%s must be a positive integer, not %q", name, value))
+			return 0, false
+		}
+		return parsed, true
+	}
+
+	parseDuration := func(name string) (time.Duration, bool) {
+		value, isSet := os.LookupEnv(name)
+		if !isSet {
+			return 0, false
+		}
+		parsed, err := time.ParseDuration(value)
+		if err != nil || parsed <= 0 {
+			errs = append(errs, fmt.Errorf("%s must be a positive duration, not %q", name, value))
+			return 0, false
+		}
+		return parsed, true
+	}
+
+	if size, ok := parseInt("SENZING_GRPC_MAX_RECV_MSG_SIZE"); ok {
+		result = append(result, grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(size)))
+	}
+	if size, ok := parseInt("SENZING_GRPC_MAX_SEND_MSG_SIZE"); ok {
+		result = append(result, grpc.WithDefaultCallOptions(grpc.MaxCallSendMsgSize(size)))
+	}
+
+	keepaliveParameters := keepalive.ClientParameters{}
+	keepaliveIsSet := false
+	if duration, ok := parseDuration("SENZING_GRPC_KEEPALIVE_TIME"); ok {
+		keepaliveParameters.Time = duration
+		keepaliveIsSet = true
+	}
+	if duration, ok := parseDuration("SENZING_GRPC_KEEPALIVE_TIMEOUT"); ok {
+		keepaliveParameters.Timeout = duration
+		keepaliveIsSet = true
+	}
+	if value, isSet := os.LookupEnv("SENZING_GRPC_KEEPALIVE_PERMIT_WITHOUT_STREAM"); isSet {
+		permit, err := strconv.ParseBool(value)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("SENZING_GRPC_KEEPALIVE_PERMIT_WITHOUT_STREAM must be a boolean, not %q", value))
+		} else {
+			keepaliveParameters.PermitWithoutStream = permit
+			keepaliveIsSet = true
+		}
+	}
+	if keepaliveIsSet {
+		result = append(result, grpc.WithKeepaliveParams(keepaliveParameters))
+	}
+
+	return result, errors.Join(errs...)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Flatten metadata into the key/value pairs expected by metadata.AppendToOutgoingContext.
+func mdPairs(md metadata.MD) []string {
+	result := []string{}
+	for key, values := range md {
+		for _, value := range values {
+			result = append(result, key, value)
+		}
+	}
+	return result
+}
+
+// Unary interceptor that attaches constant metadata to every RPC.
+func connectionMetadataUnaryInterceptor(pairs []string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(metadata.AppendToOutgoingContext(ctx, pairs...), method, req, reply, cc, opts...)
+	}
+}
+
+// Stream interceptor that attaches constant metadata to every streaming RPC.
+func connectionMetadataStreamInterceptor(pairs []string) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(metadata.AppendToOutgoingContext(ctx, pairs...), desc, cc, method, opts...)
+	}
+}
+
+// Unary interceptor that calls onUnauthenticated when an RPC fails with codes.Unauthenticated
+// and, if the hook succeeds, re-attempts the RPC once.
+func unauthenticatedUnaryInterceptor(onUnauthenticated func(ctx context.Context) error) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if status.Code(err) != codes.Unauthenticated {
+			return err
+		}
+		if hookErr := onUnauthenticated(ctx); hookErr != nil {
+			return err
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Make one attempt at obtaining the gRPC connection, from GrpcDialer or by dialing GrpcAddress
+// with the configured dial options, bounded by the dial timeout.
+func (factory *SdkAbstractFactoryImpl) dialGrpcConnection(ctx context.Context) (*grpc.ClientConn, error) {
+	if timeout := factory.getGrpcDialTimeout(); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	if factory.GrpcDialer != nil {
+		return factory.GrpcDialer(ctx)
+	}
+	target, resolverDialOptions := factory.getGrpcTarget()
+	return grpc.DialContext(ctx, target, append(factory.getGrpcDialOptions(), resolverDialOptions...)...)
+}
+
+// Compute the target to dial.  A comma-separated GrpcAddress, such as "host1:8258,host2:8258",
+// is dialed through a resolver of its own listing each address, so a load-balancing policy can
+// spread calls across them.  Any other GrpcAddress, such as "dns:///senzing:8258", is dialed as is.
+func (factory *SdkAbstractFactoryImpl) getGrpcTarget() (string, []grpc.DialOption) {
+	if !strings.Contains(factory.GrpcAddress, ",") {
+		return factory.GrpcAddress, nil
+	}
+	addresses := []resolver.Address{}
+	for _, address := range strings.Split(factory.GrpcAddress, ",") {
+		if address = strings.TrimSpace(address); len(address) > 0 {
+			addresses = append(addresses, resolver.Address{Addr: address})
+		}
+	}
+	addressListResolver := manual.NewBuilderWithScheme(grpcAddressListScheme)
+	addressListResolver.InitialState(resolver.State{Addresses: addresses})
+	return grpcAddressListScheme + ":///" + factory.GrpcAddress, []grpc.DialOption{grpc.WithResolvers(addressListResolver)}
+}
+
+// Compute the dial options for the shared gRPC connection.
+// Transport credentials come from GrpcTransportCredentials when set.  Otherwise, insecure
+// credentials are used only if GrpcOptions is nil; callers supplying GrpcOptions supply
+// their own credentials.  Options from WithGrpcDialOption follow GrpcOptions, so credentials
+// among them take precedence.  The factory's fields are never modified.
+func (factory *SdkAbstractFactoryImpl) getGrpcDialOptions() []grpc.DialOption {
+	result := factory.getGrpcEnvDialOptions()
+	if factory.GrpcTransportCredentials != nil {
+		result = append(result, grpc.WithTransportCredentials(factory.GrpcTransportCredentials))
+	} else if factory.GrpcOptions == nil {
+		result = append(result, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+	result = append(result, factory.GrpcOptions...)
+	result = append(result, factory.grpcDialOptions...)
+	result = append(result, factory.getGrpcFieldDialOptions()...)
+	return result
+}
+
+// Get the timeout for establishing the gRPC connection.  Zero means no timeout.
+func (factory *SdkAbstractFactoryImpl) getGrpcDialTimeout() time.Duration {
+	if factory.GrpcDialTimeout == nil {
+		return defaultGrpcDialTimeout
+	}
+	return *factory.GrpcDialTimeout
+}
+
+// Dial options derived from the environment, placed before GrpcOptions so explicit options win.
+func (factory *SdkAbstractFactoryImpl) getGrpcEnvDialOptions() []grpc.DialOption {
+	if !factory.GrpcDialOptionsFromEnv {
+		return []grpc.DialOption{}
+	}
+	result, err := GrpcDialOptionsFromEnv()
+	if err != nil {
+		factory.getLogger().Log(4011, err)
+	}
+	return result
+}
+
+// Dial options derived from SdkAbstractFactoryImpl fields, appended after GrpcOptions.
+// Caller-supplied interceptors come first, so they wrap the factory's own interceptors.
+func (factory *SdkAbstractFactoryImpl) getGrpcFieldDialOptions() []grpc.DialOption {
+	result := []grpc.DialOption{}
+	if len(factory.GrpcUnaryInterceptors) > 0 {
+		result = append(result, grpc.WithChainUnaryInterceptor(factory.GrpcUnaryInterceptors...))
+	}
+	if len(factory.GrpcStreamInterceptors) > 0 {
+		result = append(result, grpc.WithChainStreamInterceptor(factory.GrpcStreamInterceptors...))
+	}
+	for _, statsHandler := range factory.GrpcStatsHandlers {
+		result = append(result, grpc.WithStatsHandler(statsHandler))
+	}
+	if factory.CircuitBreaker != nil {
+		factory.circuitBreakerSyncOnce.Do(func() {
+			factory.circuitBreaker = newCircuitBreaker(*factory.CircuitBreaker)
+			factory.circuitBreaker.now = factory.getClock().Now
+		})
+		result = append(result,
+			grpc.WithChainUnaryInterceptor(factory.circuitBreaker.unaryInterceptor()),
+			grpc.WithChainStreamInterceptor(factory.circuitBreaker.streamInterceptor()),
+		)
+	}
+	if factory.GracefulShutdownTimeout > 0 {
+		result = append(result,
+			grpc.WithChainUnaryInterceptor(factory.inFlightUnaryInterceptor()),
+			grpc.WithChainStreamInterceptor(factory.inFlightStreamInterceptor()),
+		)
+	}
+	if factory.OnUnauthenticated != nil {
+		result = append(result, grpc.WithChainUnaryInterceptor(unauthenticatedUnaryInterceptor(factory.OnUnauthenticated)))
+	}
+	if len(factory.GrpcAuthority) > 0 {
+		result = append(result, grpc.WithAuthority(factory.GrpcAuthority))
+	}
+	if len(factory.GrpcCompressor) > 0 {
+		result = append(result, grpc.WithDefaultCallOptions(grpc.UseCompressor(factory.GrpcCompressor)))
+	}
+	if factory.GrpcDisableServiceConfig {
+		result = append(result, grpc.WithDisableServiceConfig())
+	}
+	if factory.GrpcMaxRecvMsgSize > 0 {
+		result = append(result, grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(factory.GrpcMaxRecvMsgSize)))
+	}
+	if factory.GrpcMaxSendMsgSize > 0 {
+		result = append(result, grpc.WithDefaultCallOptions(grpc.MaxCallSendMsgSize(factory.GrpcMaxSendMsgSize)))
+	}
+	if factory.GrpcWaitForReady {
+		result = append(result, grpc.WithDefaultCallOptions(grpc.WaitForReady(true)))
+	}
+	if factory.GrpcProxy != nil {
+		result = append(result, grpc.WithContextDialer(proxyDialer(factory.GrpcProxy)))
+	}
+	if factory.GrpcKeepalive != nil {
+		result = append(result, grpc.WithKeepaliveParams(*factory.GrpcKeepalive))
+	}
+	if factory.GrpcConnectBackoff != nil {
+		result = append(result, grpc.WithConnectParams(grpc.ConnectParams{
+			Backoff:           *factory.GrpcConnectBackoff,
+			MinConnectTimeout: grpcMinConnectTimeout,
+		}))
+	}
+	if len(factory.GrpcLoadBalancingPolicy) > 0 {
+		result = append(result, grpc.WithDefaultServiceConfig(fmt.Sprintf(`{"loadBalancingConfig": [{%q: {}}]}`, factory.GrpcLoadBalancingPolicy)))
+	}
+	pairs := mdPairs(factory.GrpcMetadata)
+	if len(pairs) > 0 {
+		result = append(result,
+			grpc.WithChainUnaryInterceptor(connectionMetadataUnaryInterceptor(pairs)),
+			grpc.WithChainStreamInterceptor(connectionMetadataStreamInterceptor(pairs)),
+		)
+	}
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Interface methods
+// ----------------------------------------------------------------------------
+
+/*
+The GrpcConnection method returns the gRPC connection shared by the factory's objects,
+dialing it if needed, so that advanced callers can make their own calls over it,
+e.g. to a custom health service.
+The factory keeps ownership: the caller must not close the connection, which Destroy
+and Reset close or forget as described for Destroy.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The shared connection, or an error wrapping ErrUnsupportedMode unless the factory is in ModeGrpc.
+*/
+func (factory *SdkAbstractFactoryImpl) GrpcConnection(ctx context.Context) (*grpc.ClientConn, error) {
+	ctx = factory.getContext(ctx)
+	factory.modeMutex.RLock()
+	defer factory.modeMutex.RUnlock()
+	if mode := factory.mode(); mode != ModeGrpc {
+		return nil, fmt.Errorf("%w: GrpcConnection requires a gRPC factory, not %s", ErrUnsupportedMode, mode)
+	}
+	if err := factory.watchBaseContext(); err != nil {
+		return nil, err
+	}
+	return factory.getGrpcConnection(ctx)
+}
@@ -0,0 +1,630 @@
+package factory
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	g2enginegrpc "github.com/senzing/g2-sdk-go-grpc/g2engine"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/stats"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// ----------------------------------------------------------------------------
+// Test doubles
+// ----------------------------------------------------------------------------
+
+// countingStatsHandler records the method of every RPC it sees.
+type countingStatsHandler struct {
+	methods []string
+	mutex   sync.Mutex
+}
+
+func (handler *countingStatsHandler) TagRPC(ctx context.Context, info *stats.RPCTagInfo) context.Context {
+	handler.mutex.Lock()
+	defer handler.mutex.Unlock()
+	handler.methods = append(handler.methods, info.FullMethodName)
+	return ctx
+}
+
+func (handler *countingStatsHandler) HandleRPC(ctx context.Context, rpcStats stats.RPCStats) {}
+
+func (handler *countingStatsHandler) TagConn(ctx context.Context, info *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+func (handler *countingStatsHandler) HandleConn(ctx context.Context, connStats stats.ConnStats) {}
+
+func (handler *countingStatsHandler) getMethods() []string {
+	handler.mutex.Lock()
+	defer handler.mutex.Unlock()
+	return append([]string{}, handler.methods...)
+}
+
+// payloadStatsHandler records the uncompressed and wire lengths of received messages.
+type payloadStatsHandler struct {
+	inPayloads []stats.InPayload
+	mutex      sync.Mutex
+}
+
+func (handler *payloadStatsHandler) TagRPC(ctx context.Context, info *stats.RPCTagInfo) context.Context {
+	return ctx
+}
+
+func (handler *payloadStatsHandler) HandleRPC(ctx context.Context, rpcStats stats.RPCStats) {
+	if inPayload, ok := rpcStats.(*stats.InPayload); ok {
+		handler.mutex.Lock()
+		defer handler.mutex.Unlock()
+		handler.inPayloads = append(handler.inPayloads, *inPayload)
+	}
+}
+
+func (handler *payloadStatsHandler) TagConn(ctx context.Context, info *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+func (handler *payloadStatsHandler) HandleConn(ctx context.Context, connStats stats.ConnStats) {}
+
+func (handler *payloadStatsHandler) getInPayloads() []stats.InPayload {
+	handler.mutex.Lock()
+	defer handler.mutex.Unlock()
+	return append([]stats.InPayload{}, handler.inPayloads...)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Start a gRPC server exposing the health service that counts the unary calls it receives.
+func startCountingTestGrpcServer(test *testing.T, calls *int32) string {
+	counter := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		atomic.AddInt32(calls, 1)
+		return handler(ctx, req)
+	}
+	return startTestGrpcServer(test, grpc.UnaryInterceptor(counter))
+}
+
+// Reserve an address and start a gRPC server exposing the health service on it after delay.
+func startDelayedTestGrpcServer(test *testing.T, delay time.Duration) string {
+	listener, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		test.Fatal(err)
+	}
+	grpcAddress := listener.Addr().String()
+	listener.Close()
+	server := grpc.NewServer()
+	grpc_health_v1.RegisterHealthServer(server, health.NewServer())
+	test.Cleanup(server.Stop)
+	go func() {
+		time.Sleep(delay)
+		listener, err := net.Listen("tcp", grpcAddress)
+		if err == nil {
+			server.Serve(listener)
+		}
+	}()
+	return grpcAddress
+}
+
+// Start an in-process gRPC server whose /test.Bytes/Get method returns as many bytes as requested.
+func startBytesTestGrpcServer(test *testing.T) string {
+	listener, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		test.Fatal(err)
+	}
+	server := grpc.NewServer()
+	server.RegisterService(&grpc.ServiceDesc{
+		ServiceName: "test.Bytes",
+		HandlerType: (*interface{})(nil),
+		Methods: []grpc.MethodDesc{{
+			MethodName: "Get",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				request := &wrapperspb.Int64Value{}
+				if err := dec(request); err != nil {
+					return nil, err
+				}
+				return wrapperspb.Bytes(make([]byte, request.Value)), nil
+			},
+		}},
+	}, struct{}{})
+	go server.Serve(listener)
+	test.Cleanup(server.Stop)
+	return listener.Addr().String()
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestConnectionMetadataUnaryInterceptor(test *testing.T) {
+	ctx := context.TODO()
+	var actual metadata.MD
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		actual, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+	interceptor := connectionMetadataUnaryInterceptor(mdPairs(metadata.Pairs("x-api-version", "1")))
+	err := interceptor(ctx, "/g2.G2Engine/Stats", nil, nil, nil, invoker)
+	testError(test, ctx, err)
+	assert.Equal(test, []string{"1"}, actual.Get("x-api-version"))
+}
+
+func TestUnauthenticatedUnaryInterceptor(test *testing.T) {
+	ctx := context.TODO()
+	refreshCount := 0
+	invokeCount := 0
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		invokeCount++
+		if refreshCount == 0 {
+			return status.Error(codes.Unauthenticated, "token expired")
+		}
+		return nil
+	}
+	interceptor := unauthenticatedUnaryInterceptor(func(ctx context.Context) error {
+		refreshCount++
+		return nil
+	})
+	err := interceptor(ctx, "/g2.G2Engine/Stats", nil, nil, nil, invoker)
+	testError(test, ctx, err)
+	assert.Equal(test, 1, refreshCount)
+	assert.Equal(test, 2, invokeCount)
+}
+
+func TestSdkAbstractFactoryImpl_getGrpcFieldDialOptions(test *testing.T) {
+	testObject := &SdkAbstractFactoryImpl{}
+	assert.Empty(test, testObject.getGrpcFieldDialOptions())
+	testObject.GrpcMetadata = metadata.Pairs("x-api-version", "1")
+	assert.Len(test, testObject.getGrpcFieldDialOptions(), 2)
+	testObject.GrpcDisableServiceConfig = true
+	assert.Len(test, testObject.getGrpcFieldDialOptions(), 3)
+}
+
+func TestSdkAbstractFactoryImpl_getGrpcDialOptions_keepalive(test *testing.T) {
+	testObject := &SdkAbstractFactoryImpl{}
+	assert.Len(test, testObject.getGrpcDialOptions(), 1, "insecure transport credentials only")
+	err := WithKeepaliveInterval(30 * time.Second)(testObject)
+	assert.NoError(test, err)
+	assert.Equal(test, 30*time.Second, testObject.GrpcKeepalive.Time)
+	assert.Len(test, testObject.getGrpcDialOptions(), 2)
+	assert.Nil(test, testObject.GrpcOptions, "keepalive must not displace the default transport credentials")
+}
+
+func TestWithUnaryInterceptor(test *testing.T) {
+	ctx := context.TODO()
+	calls := []string{}
+	countingInterceptor := func(name string) grpc.UnaryClientInterceptor {
+		return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			calls = append(calls, name+" "+method)
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+	}
+	testObject, err := New(
+		WithGrpcAddress(startTestGrpcServer(test)),
+		WithUnaryInterceptor(countingInterceptor("first")),
+		WithUnaryInterceptor(countingInterceptor("second")),
+	)
+	testError(test, ctx, err)
+	defer testObject.Destroy(ctx)
+	err = checkTestGrpcConnection(ctx, testObject)
+	testError(test, ctx, err)
+	expected := []string{
+		"first /grpc.health.v1.Health/Check",
+		"second /grpc.health.v1.Health/Check",
+	}
+	assert.Equal(test, expected, calls)
+}
+
+func TestWithStreamInterceptor(test *testing.T) {
+	testObject, err := New(
+		WithGrpcAddress("localhost:8258"),
+		WithStreamInterceptor(connectionMetadataStreamInterceptor(nil)),
+		WithStreamInterceptor(connectionMetadataStreamInterceptor(nil)),
+	)
+	assert.NoError(test, err)
+	factory := testObject.(*SdkAbstractFactoryImpl)
+	assert.Len(test, factory.GrpcStreamInterceptors, 2)
+	assert.Len(test, factory.getGrpcFieldDialOptions(), 1)
+}
+
+func TestSdkAbstractFactoryImpl_GrpcStatsHandlers(test *testing.T) {
+	ctx := context.TODO()
+	statsHandler := &countingStatsHandler{}
+	testObject, err := New(WithGrpcAddress(startTestGrpcServer(test)))
+	testError(test, ctx, err)
+	factory := testObject.(*SdkAbstractFactoryImpl)
+	factory.GrpcStatsHandlers = append(factory.GrpcStatsHandlers, statsHandler)
+	defer testObject.Destroy(ctx)
+	err = checkTestGrpcConnection(ctx, testObject)
+	testError(test, ctx, err)
+	assert.Equal(test, []string{"/grpc.health.v1.Health/Check"}, statsHandler.getMethods())
+	assert.Len(test, factory.getGrpcDialOptions(), 2, "transport credentials are kept")
+}
+
+func TestWithOtelTracing(test *testing.T) {
+	ctx := context.TODO()
+	spanExporter := tracetest.NewInMemoryExporter()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(spanExporter))
+	defer tracerProvider.Shutdown(ctx)
+	statsHandler := &countingStatsHandler{}
+	testObject, err := New(
+		WithGrpcAddress(startTestGrpcServer(test)),
+		WithGrpcDialOption(grpc.WithStatsHandler(statsHandler)),
+		WithOtelTracing(otelgrpc.WithTracerProvider(tracerProvider)),
+	)
+	testError(test, ctx, err)
+	defer testObject.Destroy(ctx)
+	g2engine, err := testObject.GetG2engine(ctx)
+	testError(test, ctx, err)
+
+	// The test server has no G2Engine service; the failed call is traced all the same.
+	_, err = g2engine.GetActiveConfigID(ctx)
+	assert.Equal(test, codes.Unimplemented, status.Code(err))
+	spans := spanExporter.GetSpans()
+	if assert.Len(test, spans, 1) {
+		assert.Equal(test, "g2.G2Engine/GetActiveConfigID", spans[0].Name)
+		assert.Equal(test, trace.SpanKindClient, spans[0].SpanKind)
+	}
+	assert.Equal(test, []string{"/g2.G2Engine/GetActiveConfigID"}, statsHandler.getMethods(), "GrpcOptions stats handlers are kept")
+	assert.Len(test, testObject.(*SdkAbstractFactoryImpl).getGrpcDialOptions(), 3, "transport credentials are kept")
+}
+
+func TestWithOtelTracing_local(test *testing.T) {
+	ctx := context.TODO()
+	spanExporter := tracetest.NewInMemoryExporter()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(spanExporter))
+	defer tracerProvider.Shutdown(ctx)
+	testObject, err := New(WithNullBackend(), WithOtelTracing(otelgrpc.WithTracerProvider(tracerProvider)))
+	testError(test, ctx, err)
+	g2engine, err := testObject.GetG2engine(ctx)
+	testError(test, ctx, err)
+	_, err = g2engine.GetActiveConfigID(ctx)
+	testError(test, ctx, err)
+	assert.Empty(test, spanExporter.GetSpans())
+}
+
+func TestSdkAbstractFactoryImpl_getGrpcDialOptions_concurrentGetters(test *testing.T) {
+	ctx := context.TODO()
+	testObject := &SdkAbstractFactoryImpl{
+		GrpcAddress: startTestGrpcServer(test),
+	}
+	defer testObject.Destroy(ctx)
+	var waitGroup sync.WaitGroup
+	waitGroup.Add(2)
+	go func() {
+		defer waitGroup.Done()
+		_, err := testObject.GetG2engine(ctx)
+		assert.NoError(test, err)
+	}()
+	go func() {
+		defer waitGroup.Done()
+		_, err := testObject.GetG2product(ctx)
+		assert.NoError(test, err)
+	}()
+	waitGroup.Wait()
+	assert.Nil(test, testObject.GrpcOptions, "the effective dial options must not be written back")
+	assert.Len(test, testObject.CreatedObjects(ctx), 2)
+}
+
+func TestWithWaitForReady(test *testing.T) {
+	testCases := []struct {
+		name         string
+		waitForReady bool
+		expectedCode codes.Code
+	}{
+		{name: "on", waitForReady: true, expectedCode: codes.OK},
+		{name: "off", waitForReady: false, expectedCode: codes.Unavailable},
+	}
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func(test *testing.T) {
+			ctx := context.TODO()
+			grpcAddress := startDelayedTestGrpcServer(test, 200*time.Millisecond)
+			testObject, err := New(WithGrpcAddress(grpcAddress), WithWaitForReady(testCase.waitForReady))
+			testError(test, ctx, err)
+			defer testObject.Destroy(ctx)
+			err = checkTestGrpcConnection(ctx, testObject)
+			assert.Equal(test, testCase.expectedCode, status.Code(err), "%v", err)
+		})
+	}
+}
+
+func TestWithCompression(test *testing.T) {
+	ctx := context.TODO()
+	grpcAddress := startBytesTestGrpcServer(test)
+	statsHandler := &payloadStatsHandler{}
+	testObject, err := New(WithGrpcAddress(grpcAddress), WithCompression("gzip"), WithGrpcDialOption(grpc.WithStatsHandler(statsHandler)))
+	testError(test, ctx, err)
+	defer testObject.Destroy(ctx)
+	grpcConnection, err := testObject.GrpcConnection(ctx)
+	testError(test, ctx, err)
+	response := &wrapperspb.BytesValue{}
+	err = grpcConnection.Invoke(ctx, "/test.Bytes/Get", wrapperspb.Int64(1<<20), response)
+	testError(test, ctx, err)
+	assert.Len(test, response.Value, 1<<20)
+	inPayloads := statsHandler.getInPayloads()
+	if assert.Len(test, inPayloads, 1) {
+		assert.Less(test, inPayloads[0].WireLength, inPayloads[0].Length, "the response was not compressed")
+	}
+}
+
+func TestWithCompression_unknown(test *testing.T) {
+	_, err := New(WithCompression("brotli"))
+	assert.ErrorContains(test, err, "brotli")
+}
+
+func TestWithLoadBalancingPolicy(test *testing.T) {
+	ctx := context.TODO()
+	var calls1, calls2 int32
+	grpcAddress := startCountingTestGrpcServer(test, &calls1) + "," + startCountingTestGrpcServer(test, &calls2)
+	testObject, err := New(WithGrpcAddress(grpcAddress), WithLoadBalancingPolicy("round_robin"))
+	testError(test, ctx, err)
+	defer testObject.Destroy(ctx)
+
+	// round_robin only uses connected servers, so keep calling until both have connected.
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) && (atomic.LoadInt32(&calls1) == 0 || atomic.LoadInt32(&calls2) == 0) {
+		err = checkTestGrpcConnection(ctx, testObject)
+		testError(test, ctx, err)
+	}
+	assert.NotZero(test, atomic.LoadInt32(&calls1), "no calls reached the first server")
+	assert.NotZero(test, atomic.LoadInt32(&calls2), "no calls reached the second server")
+}
+
+func TestWithLoadBalancingPolicy_unknown(test *testing.T) {
+	_, err := New(WithLoadBalancingPolicy("least_busy"))
+	assert.ErrorContains(test, err, "least_busy")
+}
+
+func TestSdkAbstractFactoryImpl_getGrpcTarget(test *testing.T) {
+	testObject := &SdkAbstractFactoryImpl{GrpcAddress: "dns:///senzing:8258"}
+	target, dialOptions := testObject.getGrpcTarget()
+	assert.Equal(test, "dns:///senzing:8258", target)
+	assert.Empty(test, dialOptions)
+
+	testObject = &SdkAbstractFactoryImpl{GrpcAddress: "10.0.0.5:8258, 10.0.0.6:8258"}
+	target, dialOptions = testObject.getGrpcTarget()
+	assert.Equal(test, grpcAddressListScheme+":///10.0.0.5:8258, 10.0.0.6:8258", target)
+	assert.Len(test, dialOptions, 1)
+}
+
+func TestWithMaxRecvMsgSize(test *testing.T) {
+	testCases := []struct {
+		name         string
+		options      []Option
+		expectedCode codes.Code
+	}{
+		{name: "default", expectedCode: codes.ResourceExhausted},
+		{name: "raised", options: []Option{WithMaxRecvMsgSize(8 << 20)}, expectedCode: codes.OK},
+	}
+	grpcAddress := startBytesTestGrpcServer(test)
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func(test *testing.T) {
+			ctx := context.TODO()
+			testObject, err := New(append(testCase.options, WithGrpcAddress(grpcAddress))...)
+			testError(test, ctx, err)
+			defer testObject.Destroy(ctx)
+			grpcConnection, err := testObject.GrpcConnection(ctx)
+			testError(test, ctx, err)
+			response := &wrapperspb.BytesValue{}
+			err = grpcConnection.Invoke(ctx, "/test.Bytes/Get", wrapperspb.Int64(5<<20), response)
+			assert.Equal(test, testCase.expectedCode, status.Code(err), "%v", err)
+		})
+	}
+}
+
+func TestWithMaxSendMsgSize_invalid(test *testing.T) {
+	_, err := New(WithMaxSendMsgSize(-1))
+	assert.Error(test, err)
+}
+
+func TestWithMetadata(test *testing.T) {
+	ctx := context.TODO()
+	var actual metadata.MD
+	var mutex sync.Mutex
+	recordMetadata := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		mutex.Lock()
+		actual, _ = metadata.FromIncomingContext(ctx)
+		mutex.Unlock()
+		return handler(ctx, req)
+	}
+	testObject, err := New(
+		WithGrpcAddress(startTestGrpcServer(test, grpc.UnaryInterceptor(recordMetadata))),
+		WithMetadata(metadata.Pairs("x-tenant-id", "tenant-1")),
+		WithMetadata(metadata.Pairs("x-tenant-region", "us-east-1")),
+	)
+	testError(test, ctx, err)
+	defer testObject.Destroy(ctx)
+	err = checkTestGrpcConnection(metadata.AppendToOutgoingContext(ctx, "x-correlation-id", "1001"), testObject)
+	testError(test, ctx, err)
+	mutex.Lock()
+	defer mutex.Unlock()
+	assert.Equal(test, []string{"tenant-1"}, actual.Get("x-tenant-id"))
+	assert.Equal(test, []string{"us-east-1"}, actual.Get("x-tenant-region"))
+	assert.Equal(test, []string{"1001"}, actual.Get("x-correlation-id"))
+}
+
+func TestWithMetadata_conflictingKeys(test *testing.T) {
+	testObject, err := New(
+		WithGrpcAddress("localhost:8258"),
+		WithGrpcConnectionMetadata(map[string]string{"x-api-version": "1", "X-Client": "factory"}),
+		WithMetadata(metadata.Pairs("x-api-version", "2", "x-api-version", "3")),
+	)
+	require.NoError(test, err)
+	factory := testObject.(*SdkAbstractFactoryImpl)
+	assert.Equal(test, []string{"2", "3"}, factory.GrpcMetadata.Get("x-api-version"), "the last option must win")
+	assert.Equal(test, []string{"factory"}, factory.GrpcMetadata.Get("x-client"))
+
+	testObject, err = New(
+		WithGrpcAddress("localhost:8258"),
+		WithMetadata(metadata.Pairs("x-api-version", "2")),
+		WithGrpcConnectionMetadata(map[string]string{"x-api-version": "1"}),
+	)
+	require.NoError(test, err)
+	assert.Equal(test, []string{"1"}, testObject.(*SdkAbstractFactoryImpl).GrpcMetadata.Get("x-api-version"), "the last option must win")
+}
+
+func TestSdkAbstractFactoryImpl_GrpcConnection(test *testing.T) {
+	ctx := context.TODO()
+	testObject := &SdkAbstractFactoryImpl{GrpcAddress: startTestGrpcServer(test)}
+	defer testObject.Destroy(ctx)
+	grpcConnection, err := testObject.GrpcConnection(ctx)
+	testError(test, ctx, err)
+	assert.NotNil(test, grpcConnection)
+	_, err = testObject.GetG2engine(ctx)
+	testError(test, ctx, err)
+	assert.Same(test, testObject.grpcConnection, grpcConnection, "the objects must share the returned connection")
+	response, err := grpc_health_v1.NewHealthClient(grpcConnection).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	testError(test, ctx, err)
+	assert.Equal(test, grpc_health_v1.HealthCheckResponse_SERVING, response.Status)
+}
+
+func TestSdkAbstractFactoryImpl_GrpcConnection_notGrpc(test *testing.T) {
+	ctx := context.TODO()
+	testObject := &SdkAbstractFactoryImpl{EngineConfigurationJson: `{"PIPELINE": {}}`}
+	grpcConnection, err := testObject.GrpcConnection(ctx)
+	assert.ErrorIs(test, err, ErrUnsupportedMode)
+	assert.Nil(test, grpcConnection)
+	testObject = &SdkAbstractFactoryImpl{GrpcAddress: "localhost:8258", NullBackend: true}
+	_, err = testObject.GrpcConnection(ctx)
+	assert.ErrorIs(test, err, ErrUnsupportedMode)
+}
+
+func TestWithConnectBackoff(test *testing.T) {
+	ctx := context.TODO()
+	listener, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		test.Fatal(err)
+	}
+	grpcAddress := listener.Addr().String()
+	server := grpc.NewServer()
+	grpc_health_v1.RegisterHealthServer(server, health.NewServer())
+	go server.Serve(listener)
+	defer server.Stop()
+
+	config := backoff.Config{BaseDelay: 10 * time.Millisecond, Multiplier: 1.6, Jitter: 0.2, MaxDelay: 50 * time.Millisecond}
+	testObject, err := New(WithGrpcAddress(grpcAddress), WithConnectBackoff(config))
+	testError(test, ctx, err)
+	defer testObject.Destroy(ctx)
+	factory := testObject.(*SdkAbstractFactoryImpl)
+	assert.Equal(test, config, *factory.GrpcConnectBackoff)
+	assert.Len(test, factory.getGrpcFieldDialOptions(), 1, "connect params only")
+	grpcConnection, err := testObject.GrpcConnection(ctx)
+	testError(test, ctx, err)
+	testError(test, ctx, factory.waitForGrpcReady(ctx, grpcConnection))
+
+	// Drop the connection, keep reconnecting while the server is down, then restart it.
+	server.Stop()
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	for state := grpcConnection.GetState(); state != connectivity.TransientFailure; state = grpcConnection.GetState() {
+		grpcConnection.Connect()
+		if !grpcConnection.WaitForStateChange(ctx, state) {
+			test.Fatal(ctx.Err())
+		}
+	}
+	time.Sleep(200 * time.Millisecond)
+	listener, err = net.Listen("tcp", grpcAddress)
+	if err != nil {
+		test.Fatal(err)
+	}
+	restartedServer := grpc.NewServer()
+	grpc_health_v1.RegisterHealthServer(restartedServer, health.NewServer())
+	go restartedServer.Serve(listener)
+	defer restartedServer.Stop()
+	restarted := time.Now()
+	for state := grpcConnection.GetState(); state != connectivity.Ready; state = grpcConnection.GetState() {
+		grpcConnection.Connect()
+		if !grpcConnection.WaitForStateChange(ctx, state) {
+			test.Fatal(ctx.Err())
+		}
+	}
+	assert.Less(test, time.Since(restarted), 500*time.Millisecond, "the default 1s base delay would reconnect later")
+}
+
+func TestWithConnectBackoff_invalid(test *testing.T) {
+	_, err := New(WithConnectBackoff(backoff.Config{BaseDelay: time.Second, Multiplier: 1.6, MaxDelay: time.Millisecond}))
+	assert.Error(test, err)
+	_, err = New(WithConnectBackoff(backoff.Config{BaseDelay: time.Millisecond, MaxDelay: time.Second}))
+	assert.Error(test, err, "a zero multiplier would retry without backoff")
+	assert.NoError(test, WithConnectBackoff(backoff.DefaultConfig)(&SdkAbstractFactoryImpl{}))
+}
+
+func TestWithGrpcDialOption(test *testing.T) {
+	ctx := context.TODO()
+	calls := []string{}
+	interceptor := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		calls = append(calls, method)
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	testObject, err := New(
+		WithGrpcAddress(startTestGrpcServer(test)),
+		WithGrpcDialOption(grpc.WithChainUnaryInterceptor(interceptor)),
+	)
+	testError(test, ctx, err)
+	defer testObject.Destroy(ctx)
+	assert.Nil(test, testObject.(*SdkAbstractFactoryImpl).GrpcOptions, "appended dial options must not displace the default transport credentials")
+	err = checkTestGrpcConnection(ctx, testObject)
+	testError(test, ctx, err)
+	assert.Equal(test, []string{"/grpc.health.v1.Health/Check"}, calls)
+}
+
+func TestWithKeepaliveInterval_invalid(test *testing.T) {
+	_, err := New(WithKeepaliveInterval(0))
+	assert.Error(test, err)
+}
+
+func TestGrpcDialOptionsFromEnv(test *testing.T) {
+	test.Setenv("SENZING_GRPC_MAX_RECV_MSG_SIZE", "16777216")
+	test.Setenv("SENZING_GRPC_KEEPALIVE_TIME", "30s")
+	test.Setenv("SENZING_GRPC_KEEPALIVE_TIMEOUT", "10s")
+	actual, err := GrpcDialOptionsFromEnv()
+	assert.NoError(test, err)
+	assert.Len(test, actual, 2)
+}
+
+func TestGrpcDialOptionsFromEnv_invalid(test *testing.T) {
+	test.Setenv("SENZING_GRPC_MAX_SEND_MSG_SIZE", "big")
+	test.Setenv("SENZING_GRPC_KEEPALIVE_TIME", "30s")
+	actual, err := GrpcDialOptionsFromEnv()
+	assert.ErrorContains(test, err, "SENZING_GRPC_MAX_SEND_MSG_SIZE")
+	assert.Len(test, actual, 1)
+}
+
+func TestWithUnixSocket(test *testing.T) {
+	ctx := context.TODO()
+	socketPath := filepath.Join(test.TempDir(), "senzing.sock")
+	listener, err := net.Listen("unix", socketPath)
+	testError(test, ctx, err)
+	server := grpc.NewServer()
+	grpc_health_v1.RegisterHealthServer(server, health.NewServer())
+	go server.Serve(listener)
+	defer server.Stop()
+
+	for _, option := range []Option{WithUnixSocket(socketPath), WithGrpcAddress("unix://" + socketPath)} {
+		testObject, err := New(option)
+		testError(test, ctx, err)
+		err = checkTestGrpcConnection(ctx, testObject)
+		testError(test, ctx, err)
+		g2engine, err := testObject.GetG2engine(ctx)
+		testError(test, ctx, err)
+		assert.IsType(test, &g2enginegrpc.G2engine{}, g2engine)
+		testError(test, ctx, testObject.Destroy(ctx))
+	}
+}
@@ -0,0 +1,156 @@
+package factory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Delay before WaitUntilReady repeats the first failed HealthCheck; it doubles after each failure.
+const waitUntilReadyInitialBackoff = 100 * time.Millisecond
+
+// Longest delay between two HealthCheck calls made by WaitUntilReady.
+const waitUntilReadyMaxBackoff = 5 * time.Second
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Return the gRPC connection, or nil if there is none or the factory is not in gRPC mode.
+// It is read under modeMutex, as Destroy, Reset, or SetMode may replace it concurrently.
+func (factory *SdkAbstractFactoryImpl) currentGrpcConnection() *grpc.ClientConn {
+	factory.modeMutex.RLock()
+	defer factory.modeMutex.RUnlock()
+	if !factory.isGrpc() {
+		return nil
+	}
+	return factory.grpcConnection
+}
+
+// Create each Senzing object and make one inexpensive call on it.
+func (factory *SdkAbstractFactoryImpl) checkObjects(ctx context.Context) []error {
+	errs := []error{}
+	check := func(name string, err error) {
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+		}
+	}
+
+	g2config, err := factory.GetG2config(ctx)
+	if err == nil {
+		var configHandle uintptr
+		configHandle, err = g2config.Create(ctx)
+		if err == nil {
+			err = g2config.Close(ctx, configHandle)
+		}
+	}
+	check("G2config", err)
+
+	g2configmgr, err := factory.GetG2configmgr(ctx)
+	if err == nil {
+		_, err = g2configmgr.GetDefaultConfigID(ctx)
+	}
+	check("G2configmgr", err)
+
+	g2diagnostic, err := factory.GetG2diagnostic(ctx)
+	if err == nil {
+		_, err = g2diagnostic.GetPhysicalCores(ctx)
+	}
+	check("G2diagnostic", err)
+
+	g2engine, err := factory.GetG2engine(ctx)
+	if err == nil {
+		_, err = g2engine.GetActiveConfigID(ctx)
+	}
+	check("G2engine", err)
+
+	g2product, err := factory.GetG2product(ctx)
+	if err == nil {
+		_, err = g2product.Version(ctx)
+	}
+	check("G2product", err)
+
+	return errs
+}
+
+// ----------------------------------------------------------------------------
+// Interface methods
+// ----------------------------------------------------------------------------
+
+/*
+The HealthCheck method confirms that every Senzing object can be created and answers
+an inexpensive call: G2config.Create, G2configmgr.GetDefaultConfigID,
+G2diagnostic.GetPhysicalCores, G2engine.GetActiveConfigID, and G2product.Version.
+In gRPC mode, the connection must also be in the connectivity.Ready state.
+It is suitable for a Kubernetes readiness probe.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - nil if every component is healthy; otherwise one error per unhealthy component,
+    each prefixed with the component's name, joined with errors.Join.
+*/
+func (factory *SdkAbstractFactoryImpl) HealthCheck(ctx context.Context) error {
+	ctx = factory.getContext(ctx)
+	errs := factory.checkObjects(ctx)
+	if grpcConnection := factory.currentGrpcConnection(); grpcConnection != nil {
+		if state := grpcConnection.GetState(); state != connectivity.Ready {
+			errs = append(errs, fmt.Errorf("gRPC connection: state is %s, not %s", state, connectivity.Ready))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+/*
+The WaitUntilReady method calls HealthCheck until it succeeds, waiting between calls with
+exponential backoff, from 100 milliseconds up to 5 seconds, measured by the factory's Clock.
+It replaces the retry loops callers would otherwise write around factory creation.
+
+Input
+  - ctx: A context to control lifecycle.
+  - timeout: How long to keep calling HealthCheck; zero or less waits until ctx is done.
+
+Output
+  - nil once HealthCheck succeeds; otherwise an error wrapping ErrNotReady and
+    the error of the last HealthCheck.
+*/
+func (factory *SdkAbstractFactoryImpl) WaitUntilReady(ctx context.Context, timeout time.Duration) error {
+	ctx = factory.getContext(ctx)
+	clock := factory.getClock()
+	deadline := clock.Now().Add(timeout)
+	backoff := waitUntilReadyInitialBackoff
+	for {
+		err := factory.HealthCheck(ctx)
+		if err == nil {
+			return nil
+		}
+		delay := backoff
+		if timeout > 0 {
+			remaining := deadline.Sub(clock.Now())
+			if remaining <= 0 {
+				return fmt.Errorf("%w after %s: %w", ErrNotReady, timeout, err)
+			}
+			if remaining < delay {
+				delay = remaining
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%w: %w", ErrNotReady, errors.Join(ctx.Err(), err))
+		case <-clock.After(delay):
+		}
+		backoff *= 2
+		if backoff > waitUntilReadyMaxBackoff {
+			backoff = waitUntilReadyMaxBackoff
+		}
+	}
+}
@@ -0,0 +1,228 @@
+package factory
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/senzing/g2-sdk-go/g2api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// ----------------------------------------------------------------------------
+// Test doubles
+// ----------------------------------------------------------------------------
+
+type healthG2config struct {
+	g2api.G2config
+}
+
+func (g2config *healthG2config) Close(ctx context.Context, configHandle uintptr) error {
+	return nil
+}
+
+func (g2config *healthG2config) Create(ctx context.Context) (uintptr, error) {
+	return 1, nil
+}
+
+type healthG2configmgr struct {
+	g2api.G2configmgr
+}
+
+func (g2configmgr *healthG2configmgr) GetDefaultConfigID(ctx context.Context) (int64, error) {
+	return 4015588140, nil
+}
+
+type healthG2diagnostic struct {
+	g2api.G2diagnostic
+	err error
+}
+
+func (g2diagnostic *healthG2diagnostic) GetPhysicalCores(ctx context.Context) (int, error) {
+	return 4, g2diagnostic.err
+}
+
+// recoveringG2diagnostic fails GetPhysicalCores until it has been called failures times.
+type recoveringG2diagnostic struct {
+	g2api.G2diagnostic
+	calls    atomic.Int32
+	failures int32
+}
+
+func (g2diagnostic *recoveringG2diagnostic) GetPhysicalCores(ctx context.Context) (int, error) {
+	if g2diagnostic.calls.Add(1) <= g2diagnostic.failures {
+		return 0, errors.New("database starting")
+	}
+	return 4, nil
+}
+
+type healthG2product struct {
+	g2api.G2product
+}
+
+func (g2product *healthG2product) License(ctx context.Context) (string, error) {
+	return `{"customer":"Senzing Public Test License","expireDate":"2999-11-29"}`, nil
+}
+
+func (g2product *healthG2product) Version(ctx context.Context) (string, error) {
+	return `{"VERSION":"3.4.0"}`, nil
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func getTestObjectForHealthCheck(g2diagnostic g2api.G2diagnostic) *SdkAbstractFactoryImpl {
+	result := getTestObjectWithG2engine(&activeConfigG2engine{activeConfigID: 4015588140})
+	result.g2configSyncOnce.Do(func() error {
+		result.g2configSingleton = &healthG2config{}
+		return nil
+	})
+	result.g2configmgrSyncOnce.Do(func() error {
+		result.g2configmgrSingleton = &healthG2configmgr{}
+		return nil
+	})
+	result.g2diagnosticSyncOnce.Do(func() error {
+		result.g2diagnosticSingleton = g2diagnostic
+		return nil
+	})
+	result.g2productSyncOnce.Do(func() error {
+		result.g2productSingleton = &healthG2product{}
+		return nil
+	})
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSdkAbstractFactoryImpl_HealthCheck(test *testing.T) {
+	ctx := context.TODO()
+	testObject := getTestObjectForHealthCheck(&healthG2diagnostic{})
+	err := testObject.HealthCheck(ctx)
+	testError(test, ctx, err)
+}
+
+func TestSdkAbstractFactoryImpl_HealthCheck_unhealthy(test *testing.T) {
+	ctx := context.TODO()
+	diagnosticErr := errors.New("database unavailable")
+	testObject := getTestObjectForHealthCheck(&healthG2diagnostic{err: diagnosticErr})
+	err := testObject.HealthCheck(ctx)
+	assert.ErrorIs(test, err, diagnosticErr)
+	assert.EqualError(test, err, "G2diagnostic: database unavailable")
+}
+
+func TestSdkAbstractFactoryImpl_HealthCheck_gRPC(test *testing.T) {
+	ctx := context.TODO()
+	testObject := &SdkAbstractFactoryImpl{
+		GrpcAddress: startTestGrpcServer(test),
+	}
+	defer testObject.Destroy(ctx)
+	err := testObject.HealthCheck(ctx)
+	for _, component := range []string{"G2config", "G2configmgr", "G2diagnostic", "G2engine", "G2product"} {
+		assert.ErrorContains(test, err, component+": ")
+	}
+}
+
+func TestSdkAbstractFactoryImpl_HealthCheck_concurrentDestroy(test *testing.T) {
+	ctx := context.TODO()
+	grpcConnection, err := grpc.Dial(startTestGrpcServer(test), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(test, err)
+	defer grpcConnection.Close()
+	testObject := getTestObjectForHealthCheck(&healthG2diagnostic{})
+	testObject.GrpcAddress = "localhost:8261"
+	testObject.grpcConnection = grpcConnection
+
+	// Destroy releases the connection under the write lock while HealthCheck reads it.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			testObject.modeMutex.Lock()
+			if testObject.grpcConnection == nil {
+				testObject.grpcConnection = grpcConnection
+			} else {
+				testObject.grpcConnection = nil
+			}
+			testObject.modeMutex.Unlock()
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		_ = testObject.HealthCheck(ctx)
+	}
+	<-done
+}
+
+func TestSdkAbstractFactoryImpl_WaitUntilReady(test *testing.T) {
+	ctx := context.TODO()
+	testObject, err := New(WithNullBackend())
+	testError(test, ctx, err)
+	testError(test, ctx, testObject.WaitUntilReady(ctx, time.Second))
+}
+
+func TestSdkAbstractFactoryImpl_WaitUntilReady_recovers(test *testing.T) {
+	ctx := context.TODO()
+	clock := newFakeClock(time.Now())
+	g2diagnostic := &recoveringG2diagnostic{failures: 3}
+	testObject := getTestObjectForHealthCheck(g2diagnostic)
+	testError(test, ctx, WithClock(clock)(testObject))
+	result := make(chan error, 1)
+	go func() {
+		result <- testObject.WaitUntilReady(ctx, time.Minute)
+	}()
+	for _, expected := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond} {
+		assert.Equal(test, expected, clock.awaitWaiter(test))
+		clock.Advance(expected)
+	}
+	testError(test, ctx, <-result)
+	assert.Equal(test, int32(4), g2diagnostic.calls.Load())
+}
+
+func TestSdkAbstractFactoryImpl_WaitUntilReady_timeout(test *testing.T) {
+	ctx := context.TODO()
+	clock := newFakeClock(time.Now())
+	diagnosticErr := errors.New("database unavailable")
+	testObject := getTestObjectForHealthCheck(&healthG2diagnostic{err: diagnosticErr})
+	testError(test, ctx, WithClock(clock)(testObject))
+	result := make(chan error, 1)
+	go func() {
+		result <- testObject.WaitUntilReady(ctx, 10*time.Second)
+	}()
+
+	// The backoff doubles up to 5 seconds and is cut short by the timeout.
+	for _, expected := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond,
+		800 * time.Millisecond, 1600 * time.Millisecond, 3200 * time.Millisecond, 3700 * time.Millisecond} {
+		assert.Equal(test, expected, clock.awaitWaiter(test))
+		clock.Advance(expected)
+	}
+	err := <-result
+	assert.ErrorIs(test, err, ErrNotReady)
+	assert.ErrorIs(test, err, diagnosticErr)
+}
+
+func TestSdkAbstractFactoryImpl_WaitUntilReady_contextDone(test *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	diagnosticErr := errors.New("database unavailable")
+	testObject := getTestObjectForHealthCheck(&healthG2diagnostic{err: diagnosticErr})
+	err := testObject.WaitUntilReady(ctx, 0)
+	assert.ErrorIs(test, err, ErrNotReady)
+	assert.ErrorIs(test, err, context.DeadlineExceeded)
+	assert.ErrorIs(test, err, diagnosticErr)
+}
+
+func TestSdkAbstractFactoryImpl_WaitUntilReady_gRPC(test *testing.T) {
+	ctx := context.TODO()
+	testObject, err := New(WithGrpcAddress(getUnreachableGrpcAddress(test)))
+	testError(test, ctx, err)
+	defer testObject.Destroy(ctx)
+	err = testObject.WaitUntilReady(ctx, 300*time.Millisecond)
+	assert.ErrorIs(test, err, ErrNotReady)
+	assert.ErrorContains(test, err, "gRPC connection: state is")
+}
@@ -0,0 +1,170 @@
+package factory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/senzing/g2-sdk-go/g2api"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// initializingContextKey marks the context of the GetG2* calls made by Initialize itself.
+type initializingContextKey struct{}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Wait, bounded by the dial timeout and ctx, until grpcConnection is connectivity.Ready.
+// A connection in connectivity.TransientFailure is reconnected, with backoff, until then,
+// so a server that is still starting is waited for; a closed connection is reported at once.
+func (factory *SdkAbstractFactoryImpl) waitForGrpcReady(ctx context.Context, grpcConnection *grpc.ClientConn) error {
+	if timeout := factory.getGrpcDialTimeout(); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	for {
+		state := grpcConnection.GetState()
+		switch state {
+		case connectivity.Idle:
+			// A connection returns to idle after a failed attempt; start the next one.
+			grpcConnection.Connect()
+		case connectivity.Ready:
+			return nil
+		case connectivity.Shutdown:
+			return fmt.Errorf("%w: %s: state is %s", ErrGrpcDial, grpcConnection.Target(), state)
+		}
+		if !grpcConnection.WaitForStateChange(ctx, state) {
+			return fmt.Errorf("%w: %s: %w", ErrGrpcDial, grpcConnection.Target(), ctx.Err())
+		}
+	}
+}
+
+// Initialize the factory if EagerInitialization is set, destroying it on failure.
+// Called by New and Clone on the factory they are about to return.
+func (factory *SdkAbstractFactoryImpl) initializeIfEager() error {
+	if !factory.EagerInitialization {
+		return nil
+	}
+	if err := factory.Initialize(context.Background()); err != nil {
+		factory.Destroy(context.Background())
+		return err
+	}
+	return nil
+}
+
+// Delete every record and entity with g2engine if PurgeOnInit is set and confirmed.
+// Called by an explicit Initialize once all objects exist.
+func (factory *SdkAbstractFactoryImpl) purgeOnInit(ctx context.Context, g2engine g2api.G2engine) error {
+	if !factory.PurgeOnInit {
+		return nil
+	}
+	if err := factory.validatePurgeOnInit(); err != nil {
+		return err
+	}
+	factory.getLogger().Log(3004, factory.Mode())
+	return g2engine.PurgeRepository(ctx)
+}
+
+// With EagerInitialization, make sure Initialize has run before caller creates an object:
+// run it now, without purging, or, with StrictEager, fail with ErrNotInitialized.
+// Initialize runs again after Destroy or Reset.  Called by the GetG2* methods before they lock modeMutex.
+func (factory *SdkAbstractFactoryImpl) initializeIfPending(ctx context.Context, caller string) error {
+	if !factory.EagerInitialization || factory.initialized.Load() || ctx.Value(initializingContextKey{}) != nil {
+		return nil
+	}
+	if factory.StrictEager {
+		return fmt.Errorf("%w: %s was called before Initialize; WithStrictEager requires Initialize first", ErrNotInitialized, caller)
+	}
+	factory.initializeMutex.Lock()
+	defer factory.initializeMutex.Unlock()
+	if factory.initialized.Load() {
+		return nil
+	}
+	return factory.initialize(ctx, false)
+}
+
+// Create all objects and run the startup checks; purge is set only by an explicit Initialize.
+// The caller holds initializeMutex.
+func (factory *SdkAbstractFactoryImpl) initialize(ctx context.Context, purge bool) error {
+	ctx = context.WithValue(factory.getContext(ctx), initializingContextKey{}, true)
+	grpcConnection, err := factory.getInitialGrpcConnection(ctx)
+	if err != nil {
+		return err
+	}
+	if grpcConnection != nil {
+		if err := factory.waitForGrpcReady(ctx, grpcConnection); err != nil {
+			return err
+		}
+	}
+	objects, err := factory.GetAll(ctx)
+	if err != nil {
+		return err
+	}
+	if purge {
+		if err := factory.purgeOnInit(ctx, objects.G2engine); err != nil {
+			return err
+		}
+	}
+	if err := factory.checkDBPerfGate(ctx, objects.G2diagnostic); err != nil {
+		return err
+	}
+	if err := factory.checkLicense(ctx); err != nil {
+		return err
+	}
+	factory.initialized.Store(true)
+	return nil
+}
+
+// Return the gRPC connection, creating it if needed, or nil if the factory is not in gRPC mode.
+func (factory *SdkAbstractFactoryImpl) getInitialGrpcConnection(ctx context.Context) (*grpc.ClientConn, error) {
+	factory.modeMutex.RLock()
+	defer factory.modeMutex.RUnlock()
+	if !factory.isGrpc() {
+		return nil, nil
+	}
+	if err := factory.watchBaseContext(); err != nil {
+		return nil, err
+	}
+	return factory.getGrpcConnection(ctx)
+}
+
+// ----------------------------------------------------------------------------
+// Interface methods
+// ----------------------------------------------------------------------------
+
+/*
+The Initialize method creates all five Senzing objects now rather than on first use,
+so configuration and connection errors surface at startup.
+In gRPC mode, it first waits, up to the dial timeout or the deadline of ctx, for the connection
+to become ready, retrying while the server is unavailable.
+The wait does not hold the factory's lock, so Destroy and SetMode are not blocked by it.
+WithEagerInitialization makes New call Initialize.  In eager mode, a GetG2* call made before
+Initialize, e.g. on a factory built without New or after Destroy or Reset, runs Initialize first;
+with WithStrictEager, it fails with ErrNotInitialized instead.
+Initialize and that implicit run are serialized, so a getter waits for an Initialize in progress.
+With WithPurgeOnInit and WithPurgeOnInitConfirmed, every explicit call, including the one made by New,
+then purges the repository; the implicit run never purges.
+With WithDBPerfGate, it then checks the database's insert rate.
+Finally, a license that has expired, or expires within 30 days, is logged as a warning and
+reported to observers; WithStrictLicense makes an expired license an error.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The first error encountered; an unreachable gRPC server yields an error wrapping ErrGrpcDial,
+    a slow database one wrapping ErrDBPerformance, and, with WithStrictLicense, an expired
+    license one wrapping ErrLicenseExpired.
+*/
+func (factory *SdkAbstractFactoryImpl) Initialize(ctx context.Context) error {
+	factory.initializeMutex.Lock()
+	defer factory.initializeMutex.Unlock()
+	return factory.initialize(ctx, true)
+}
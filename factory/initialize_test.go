@@ -0,0 +1,312 @@
+package factory
+
+import (
+	"context"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// ----------------------------------------------------------------------------
+// Test doubles
+// ----------------------------------------------------------------------------
+
+// purgeG2engine counts the calls to PurgeRepository.
+type purgeG2engine struct {
+	nullG2engine
+	purges int
+}
+
+func (g2engine *purgeG2engine) PurgeRepository(ctx context.Context) error {
+	g2engine.purges++
+	return nil
+}
+
+// blockingPurgeG2engine signals started from PurgeRepository, then waits for release.
+type blockingPurgeG2engine struct {
+	nullG2engine
+	purges  atomic.Int32
+	release chan struct{}
+	started chan struct{}
+}
+
+func (g2engine *blockingPurgeG2engine) PurgeRepository(ctx context.Context) error {
+	if g2engine.purges.Add(1) == 1 {
+		close(g2engine.started)
+		<-g2engine.release
+	}
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Return a null-backend factory whose G2engine is g2engine.
+func getTestObjectForPurge(g2engine *purgeG2engine, logger *recordingLogger) *SdkAbstractFactoryImpl {
+	result := getTestObjectWithG2engine(g2engine)
+	result.logger = logger
+	result.NullBackend = true
+	return result
+}
+
+// Return the address of a local port that nothing listens on.
+func getUnreachableGrpcAddress(test *testing.T) string {
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(test, err)
+	result := listener.Addr().String()
+	listener.Close()
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestWithEagerInitialization(test *testing.T) {
+	ctx := context.TODO()
+	testObject, err := New(WithGrpcAddress(startTestGrpcServer(test)), WithEagerInitialization())
+	testError(test, ctx, err)
+	defer testObject.Destroy(ctx)
+	assert.Len(test, testObject.CreatedObjects(ctx), 5)
+	grpcConnection, err := testObject.GrpcConnection(ctx)
+	testError(test, ctx, err)
+	assert.Equal(test, connectivity.Ready, grpcConnection.GetState())
+}
+
+func TestWithEagerInitialization_unreachable(test *testing.T) {
+	testObject, err := New(WithGrpcAddress(getUnreachableGrpcAddress(test)), WithEagerInitialization(), WithDialTimeout(500*time.Millisecond))
+	assert.ErrorIs(test, err, ErrGrpcDial)
+	assert.ErrorIs(test, err, context.DeadlineExceeded)
+	assert.Nil(test, testObject)
+}
+
+func TestSdkAbstractFactoryImpl_Initialize_serverStartsLate(test *testing.T) {
+	ctx := context.TODO()
+	grpcAddress := getUnreachableGrpcAddress(test)
+	testObject, err := New(WithGrpcAddress(grpcAddress), WithDialTimeout(10*time.Second),
+		WithConnectBackoff(backoff.Config{BaseDelay: 50 * time.Millisecond, Multiplier: 1, MaxDelay: 50 * time.Millisecond}))
+	testError(test, ctx, err)
+	defer testObject.Destroy(ctx)
+	server := grpc.NewServer()
+	grpc_health_v1.RegisterHealthServer(server, health.NewServer())
+	test.Cleanup(server.Stop)
+	time.AfterFunc(300*time.Millisecond, func() {
+		listener, err := net.Listen("tcp", grpcAddress)
+		if err != nil {
+			return
+		}
+		server.Serve(listener)
+	})
+	testError(test, ctx, testObject.Initialize(ctx))
+	assert.Len(test, testObject.CreatedObjects(ctx), 5)
+}
+
+func TestWithEagerInitialization_null(test *testing.T) {
+	ctx := context.TODO()
+	testObject, err := New(WithNullBackend(), WithEagerInitialization())
+	testError(test, ctx, err)
+	assert.Len(test, testObject.CreatedObjects(ctx), 5)
+}
+
+func TestNew_lazyByDefault(test *testing.T) {
+	ctx := context.TODO()
+	testObject, err := New(WithGrpcAddress(getUnreachableGrpcAddress(test)))
+	testError(test, ctx, err)
+	assert.Empty(test, testObject.CreatedObjects(ctx))
+}
+
+func TestWithPurgeOnInit(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &purgeG2engine{}
+	logger := &recordingLogger{}
+	testObject := getTestObjectForPurge(g2engine, logger)
+	testError(test, ctx, WithPurgeOnInit()(testObject))
+	testError(test, ctx, WithPurgeOnInitConfirmed()(testObject))
+	testError(test, ctx, testObject.Validate())
+	testError(test, ctx, testObject.Initialize(ctx))
+	assert.Equal(test, 1, g2engine.purges)
+	assert.Contains(test, logger.messageNumbers, 3004)
+}
+
+func TestWithPurgeOnInit_notByDefault(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &purgeG2engine{}
+	logger := &recordingLogger{}
+	testObject := getTestObjectForPurge(g2engine, logger)
+	testError(test, ctx, testObject.Initialize(ctx))
+	assert.Zero(test, g2engine.purges)
+	assert.NotContains(test, logger.messageNumbers, 3004)
+}
+
+func TestWithPurgeOnInit_unconfirmed(test *testing.T) {
+	ctx := context.TODO()
+	_, err := New(WithNullBackend(), WithPurgeOnInit())
+	assert.ErrorIs(test, err, ErrConflictingConfiguration)
+
+	// A factory configured without New is refused by Initialize.
+	g2engine := &purgeG2engine{}
+	testObject := getTestObjectForPurge(g2engine, &recordingLogger{})
+	testObject.PurgeOnInit = true
+	assert.ErrorIs(test, testObject.Validate(), ErrConflictingConfiguration)
+	assert.ErrorIs(test, testObject.Initialize(ctx), ErrConflictingConfiguration)
+	assert.Zero(test, g2engine.purges)
+}
+
+func TestWithPurgeOnInit_marshalConfig(test *testing.T) {
+	original, err := New(WithNullBackend(), WithPurgeOnInit(), WithPurgeOnInitConfirmed())
+	require.NoError(test, err)
+	config, err := original.(*SdkAbstractFactoryImpl).MarshalConfig()
+	require.NoError(test, err)
+	assert.Contains(test, string(config), `"purgeOnInit": true`)
+	assert.Contains(test, string(config), `"PurgeOnInitConfirmed"`)
+
+	// The confirmation is not restored from the configuration alone.
+	_, err = NewFromConfig(config)
+	assert.ErrorIs(test, err, ErrConflictingConfiguration)
+	restored, err := NewFromConfig(config, WithPurgeOnInitConfirmed())
+	require.NoError(test, err)
+	assert.Equal(test, original, restored)
+}
+
+func TestWithEagerInitialization_getBeforeInitialize(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &purgeG2engine{}
+	testObject := getTestObjectForPurge(g2engine, &recordingLogger{})
+	testObject.EagerInitialization = true
+	testError(test, ctx, WithPurgeOnInit()(testObject))
+	testError(test, ctx, WithPurgeOnInitConfirmed()(testObject))
+
+	// Concurrent getters run Initialize once, before any of them returns, and do not purge.
+	var waitGroup sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			_, err := testObject.GetG2product(ctx)
+			assert.NoError(test, err)
+		}()
+	}
+	waitGroup.Wait()
+	assert.Zero(test, g2engine.purges)
+	assert.Len(test, testObject.CreatedObjects(ctx), 5)
+}
+
+func TestWithPurgeOnInit_notAfterDestroy(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &purgeG2engine{}
+	testObject := getTestObjectForPurge(g2engine, &recordingLogger{})
+	testObject.EagerInitialization = true
+	testError(test, ctx, WithPurgeOnInit()(testObject))
+	testError(test, ctx, WithPurgeOnInitConfirmed()(testObject))
+	testError(test, ctx, testObject.Initialize(ctx))
+	assert.Equal(test, 1, g2engine.purges)
+
+	// Recreating the objects after Destroy runs Initialize again, but must not purge again.
+	testError(test, ctx, testObject.Destroy(ctx))
+	testObject.g2engineSyncOnce.Do(func() error {
+		testObject.g2engineSingleton = g2engine
+		return nil
+	})
+	_, err := testObject.GetG2product(ctx)
+	testError(test, ctx, err)
+	assert.Len(test, testObject.CreatedObjects(ctx), 5)
+	assert.Equal(test, 1, g2engine.purges)
+}
+
+func TestSdkAbstractFactoryImpl_Initialize_serializedWithGetters(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &blockingPurgeG2engine{started: make(chan struct{}), release: make(chan struct{})}
+	testObject := getTestObjectWithG2engine(g2engine)
+	testObject.NullBackend = true
+	testObject.EagerInitialization = true
+	testError(test, ctx, WithPurgeOnInit()(testObject))
+	testError(test, ctx, WithPurgeOnInitConfirmed()(testObject))
+
+	initialized := make(chan error, 1)
+	go func() { initialized <- testObject.Initialize(ctx) }()
+	<-g2engine.started
+	got := make(chan error, 1)
+	go func() {
+		_, err := testObject.GetG2product(ctx)
+		got <- err
+	}()
+	select {
+	case <-got:
+		test.Fatal("GetG2product returned while Initialize was in progress")
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(g2engine.release)
+	testError(test, ctx, <-initialized)
+	testError(test, ctx, <-got)
+	assert.Equal(test, int32(1), g2engine.purges.Load())
+}
+
+func TestWithEagerInitialization_getAfterDestroy(test *testing.T) {
+	ctx := context.TODO()
+	testObject, err := New(WithNullBackend(), WithEagerInitialization())
+	testError(test, ctx, err)
+	testError(test, ctx, testObject.Destroy(ctx))
+	assert.Empty(test, testObject.CreatedObjects(ctx))
+	_, err = testObject.GetG2engine(ctx)
+	testError(test, ctx, err)
+	assert.Len(test, testObject.CreatedObjects(ctx), 5)
+}
+
+func TestWithEagerInitialization_getG2engineWithConfigID(test *testing.T) {
+	ctx := context.TODO()
+	testObject, err := New(WithNullBackend(), WithEagerInitialization())
+	testError(test, ctx, err)
+	testError(test, ctx, testObject.Destroy(ctx))
+	_, err = testObject.GetG2engineWithConfigID(ctx, 42)
+	assert.ErrorIs(test, err, ErrConflictingConfiguration, "Initialize must run first and create the default G2engine")
+	assert.Len(test, testObject.CreatedObjects(ctx), 5)
+}
+
+func TestWithStrictEager(test *testing.T) {
+	ctx := context.TODO()
+	testObject, err := New(WithNullBackend(), WithEagerInitialization(), WithStrictEager())
+	testError(test, ctx, err)
+	_, err = testObject.GetG2engine(ctx)
+	testError(test, ctx, err)
+
+	testError(test, ctx, testObject.Reset(ctx, true))
+	_, err = testObject.GetG2engine(ctx)
+	assert.ErrorIs(test, err, ErrNotInitialized)
+	assert.ErrorContains(test, err, "GetG2engine was called before Initialize")
+	assert.Empty(test, testObject.CreatedObjects(ctx))
+
+	testError(test, ctx, testObject.Initialize(ctx))
+	_, err = testObject.GetG2engine(ctx)
+	testError(test, ctx, err)
+}
+
+func TestWithStrictEager_getG2engineWithConfigID(test *testing.T) {
+	ctx := context.TODO()
+	testObject, err := New(WithNullBackend(), WithEagerInitialization(), WithStrictEager())
+	testError(test, ctx, err)
+	testError(test, ctx, testObject.Reset(ctx, true))
+	_, err = testObject.GetG2engineWithConfigID(ctx, 42)
+	assert.ErrorIs(test, err, ErrNotInitialized)
+	assert.ErrorContains(test, err, "GetG2engineWithConfigID was called before Initialize")
+	assert.Empty(test, testObject.CreatedObjects(ctx))
+}
+
+func TestWithStrictEager_lazy(test *testing.T) {
+	ctx := context.TODO()
+	testObject, err := New(WithNullBackend(), WithStrictEager())
+	testError(test, ctx, err)
+	_, err = testObject.GetG2engine(ctx)
+	testError(test, ctx, err)
+	assert.Len(test, testObject.CreatedObjects(ctx), 1)
+}
@@ -0,0 +1,238 @@
+package factory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// License is the license returned by the License method; it is a LicenseInfo.
+type License = LicenseInfo
+
+// LicenseInfo is the typed form of the JSON returned by G2product.License.
+// Expired reports whether the expiration date had passed when the license was returned.
+type LicenseInfo struct {
+	Billing      string
+	Contract     string
+	Customer     string
+	Expiration   time.Time
+	Expired      bool
+	IssueDate    time.Time
+	LicenseLevel string
+	LicenseType  string
+	RecordLimit  int64
+}
+
+// licenseJson mirrors the JSON document returned by G2product.License.
+type licenseJson struct {
+	Billing      string `json:"billing"`
+	Contract     string `json:"contract"`
+	Customer     string `json:"customer"`
+	ExpireDate   string `json:"expireDate"`
+	IssueDate    string `json:"issueDate"`
+	LicenseLevel string `json:"licenseLevel"`
+	LicenseType  string `json:"licenseType"`
+	RecordLimit  int64  `json:"recordLimit"`
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Time License caches the license for when LicenseCacheTTL is nil.
+const defaultLicenseCacheTTL = time.Hour
+
+// Layout of the dates in the Senzing license JSON, e.g. "2023-11-29".
+const licenseDateLayout = "2006-01-02"
+
+// How long before its expiration Initialize warns that the license expires soon.
+const licenseExpiryWarningPeriod = 30 * 24 * time.Hour
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Parse a date from the license JSON.  An empty string yields the zero time.
+func parseLicenseDate(name string, value string) (time.Time, error) {
+	if len(value) == 0 {
+		return time.Time{}, nil
+	}
+	result, err := time.Parse(licenseDateLayout, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("cannot parse license %s %q: %w", name, value, err)
+	}
+	return result, nil
+}
+
+// Parse the JSON returned by G2product.License.
+func parseLicenseInfo(license string) (LicenseInfo, error) {
+	parsed := licenseJson{}
+	err := json.Unmarshal([]byte(license), &parsed)
+	if err != nil {
+		return LicenseInfo{}, fmt.Errorf("cannot parse license: %w", err)
+	}
+	expiration, err := parseLicenseDate("expireDate", parsed.ExpireDate)
+	if err != nil {
+		return LicenseInfo{}, err
+	}
+	issueDate, err := parseLicenseDate("issueDate", parsed.IssueDate)
+	if err != nil {
+		return LicenseInfo{}, err
+	}
+	result := LicenseInfo{
+		Billing:      parsed.Billing,
+		Contract:     parsed.Contract,
+		Customer:     parsed.Customer,
+		Expiration:   expiration,
+		IssueDate:    issueDate,
+		LicenseLevel: parsed.LicenseLevel,
+		LicenseType:  parsed.LicenseType,
+		RecordLimit:  parsed.RecordLimit,
+	}
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// LicenseInfo methods
+// ----------------------------------------------------------------------------
+
+// Err returns an error wrapping ErrLicenseExpired if the license is Expired, and nil otherwise.
+func (licenseInfo LicenseInfo) Err() error {
+	if !licenseInfo.Expired {
+		return nil
+	}
+	return fmt.Errorf("%w: %s expired on %s", ErrLicenseExpired, licenseInfo.Customer, licenseInfo.Expiration.Format(licenseDateLayout))
+}
+
+// Report whether the license had expired at now.  It is valid through its expiration date;
+// a license without one never expires.
+func (licenseInfo LicenseInfo) expiredAt(now time.Time) bool {
+	return !licenseInfo.Expiration.IsZero() && !now.Before(licenseInfo.Expiration.AddDate(0, 0, 1))
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Warn, with a log message and an observer notification, if the license has expired or expires
+// within licenseExpiryWarningPeriod.  With StrictLicense, an expired license, or one that cannot be
+// read, is returned as an error.  Called by Initialize once all objects exist.
+func (factory *SdkAbstractFactoryImpl) checkLicense(ctx context.Context) error {
+	licenseInfo, err := factory.LicenseInfo(ctx)
+	if err != nil {
+		if factory.StrictLicense {
+			return err
+		}
+		factory.getLogger().Log(3007, err)
+		return nil
+	}
+	if licenseInfo.Expiration.IsZero() {
+		return nil
+	}
+	expireDate := licenseInfo.Expiration.Format(licenseDateLayout)
+	details := map[string]string{"customer": licenseInfo.Customer, "expireDate": expireDate}
+	if licenseInfo.Expired {
+		factory.getLogger().Log(3005, licenseInfo.Customer, expireDate)
+		factory.notify(ctx, 8008, licenseInfo.Err(), details)
+		if factory.StrictLicense {
+			return licenseInfo.Err()
+		}
+		return nil
+	}
+	if licenseInfo.Expiration.Sub(factory.getClock().Now()) < licenseExpiryWarningPeriod {
+		factory.getLogger().Log(3006, licenseInfo.Customer, expireDate)
+		factory.notify(ctx, 8009, nil, details)
+	}
+	return nil
+}
+
+// Fetch and parse the license from the factory's G2product.
+func (factory *SdkAbstractFactoryImpl) fetchLicenseInfo(ctx context.Context) (LicenseInfo, error) {
+	g2product, err := factory.GetG2product(ctx)
+	if err != nil {
+		return LicenseInfo{}, err
+	}
+	license, err := g2product.License(ctx)
+	if err != nil {
+		return LicenseInfo{}, err
+	}
+	return parseLicenseInfo(license)
+}
+
+// Return LicenseCacheTTL, or defaultLicenseCacheTTL when it is nil.
+func (factory *SdkAbstractFactoryImpl) getLicenseCacheTTL() time.Duration {
+	if factory.LicenseCacheTTL == nil {
+		return defaultLicenseCacheTTL
+	}
+	return *factory.LicenseCacheTTL
+}
+
+// ----------------------------------------------------------------------------
+// Interface methods
+// ----------------------------------------------------------------------------
+
+/*
+The License method returns the Senzing license details, like LicenseInfo, but caches them
+for LicenseCacheTTL (an hour by default) so that frequent callers do not query G2product each time.
+Destroy and Reset discard the cached license.
+A license is expired once its expiration date has passed; the expiration is checked
+on every call, including those answered from the cache.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The parsed license details; empty in ModeNull.
+    An expired license is returned together with an error wrapping ErrLicenseExpired.
+*/
+func (factory *SdkAbstractFactoryImpl) License(ctx context.Context) (*License, error) {
+	ctx = factory.getContext(ctx)
+	if factory.NullBackend {
+		return &License{}, nil
+	}
+	factory.licenseMutex.Lock()
+	defer factory.licenseMutex.Unlock()
+	now := factory.getClock().Now()
+	if factory.licenseCache == nil || now.Sub(factory.licenseCachedAt) >= factory.getLicenseCacheTTL() {
+		licenseInfo, err := factory.fetchLicenseInfo(ctx)
+		if err != nil {
+			return nil, err
+		}
+		factory.licenseCache = &licenseInfo
+		factory.licenseCachedAt = now
+	}
+	result := *factory.licenseCache
+	result.Expired = result.expiredAt(now)
+	return &result, result.Err()
+}
+
+/*
+The LicenseInfo method returns the Senzing license details as a typed structure.
+It obtains the G2product object from the factory and parses the JSON returned by G2product.License
+on every call; License caches them instead.
+A license is Expired once its expiration date has passed.  An expired license is not an error:
+check Expired, or call Err for an error wrapping ErrLicenseExpired.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The parsed license details; empty in ModeNull.
+*/
+func (factory *SdkAbstractFactoryImpl) LicenseInfo(ctx context.Context) (LicenseInfo, error) {
+	ctx = factory.getContext(ctx)
+	if factory.NullBackend {
+		return LicenseInfo{}, nil
+	}
+	result, err := factory.fetchLicenseInfo(ctx)
+	if err != nil {
+		return LicenseInfo{}, err
+	}
+	result.Expired = result.expiredAt(factory.getClock().Now())
+	return result, nil
+}
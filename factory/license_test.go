@@ -0,0 +1,212 @@
+package factory
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/senzing/g2-sdk-go/g2api"
+	"github.com/stretchr/testify/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Test doubles
+// ----------------------------------------------------------------------------
+
+type licenseG2product struct {
+	g2api.G2product
+	err     error
+	license string
+}
+
+func (g2product *licenseG2product) License(ctx context.Context) (string, error) {
+	return g2product.license, g2product.err
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Return a factory with all five objects, whose G2product returns license on 1 March 2024.
+// The observer is registered on the factory only, as the objects are test doubles.
+func getTestObjectForLicenseCheck(test *testing.T, g2product *licenseG2product, options ...Option) (*SdkAbstractFactoryImpl, *recordingLogger, *recordingObserver) {
+	ctx := context.TODO()
+	logger := &recordingLogger{}
+	testObject := &SdkAbstractFactoryImpl{logger: logger}
+	options = append(options, WithClock(newFakeClock(time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC))))
+	for _, option := range options {
+		testError(test, ctx, option(testObject))
+	}
+	anObserver := &recordingObserver{}
+	testError(test, ctx, testObject.RegisterObserver(ctx, anObserver))
+	testObject.g2configSyncOnce.Do(func() error {
+		testObject.g2configSingleton = &healthG2config{}
+		return nil
+	})
+	testObject.g2configmgrSyncOnce.Do(func() error {
+		testObject.g2configmgrSingleton = &healthG2configmgr{}
+		return nil
+	})
+	testObject.g2diagnosticSyncOnce.Do(func() error {
+		testObject.g2diagnosticSingleton = &healthG2diagnostic{}
+		return nil
+	})
+	testObject.g2engineSyncOnce.Do(func() error {
+		testObject.g2engineSingleton = &activeConfigG2engine{}
+		return nil
+	})
+	testObject.g2productSyncOnce.Do(func() error {
+		testObject.g2productSingleton = g2product
+		return nil
+	})
+	return testObject, logger, anObserver
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSdkAbstractFactoryImpl_LicenseInfo(test *testing.T) {
+	ctx := context.TODO()
+	testObject := getTestObjectWithG2product(&licenseG2product{
+		license: `{"customer":"Senzing Public Test License","contract":"EVALUATION - support@senzing.com","issueDate":"2022-11-29","licenseType":"EVAL (Solely for non-productive use)","licenseLevel":"STANDARD","billing":"MONTHLY","expireDate":"2023-11-29","recordLimit":50000}`,
+	})
+	actual, err := testObject.LicenseInfo(ctx)
+	testError(test, ctx, err)
+	assert.Equal(test, "Senzing Public Test License", actual.Customer)
+	assert.Equal(test, time.Date(2023, time.November, 29, 0, 0, 0, 0, time.UTC), actual.Expiration)
+	assert.Equal(test, int64(50000), actual.RecordLimit)
+}
+
+func TestSdkAbstractFactoryImpl_LicenseInfo_malformed(test *testing.T) {
+	ctx := context.TODO()
+	testObject := getTestObjectWithG2product(&licenseG2product{
+		license: `{"expireDate":"11/29/2023"}`,
+	})
+	actual, err := testObject.LicenseInfo(ctx)
+	assert.ErrorContains(test, err, "expireDate")
+	assert.NotErrorIs(test, err, ErrLicenseExpired)
+	assert.Equal(test, LicenseInfo{}, actual)
+}
+
+func TestSdkAbstractFactoryImpl_LicenseInfo_uncached(test *testing.T) {
+	ctx := context.TODO()
+	g2product := &licenseG2product{license: `{"customer":"Senzing Public Test License","expireDate":"2999-11-29"}`}
+	testObject := getTestObjectWithG2product(g2product)
+	_, err := testObject.LicenseInfo(ctx)
+	testError(test, ctx, err)
+	g2product.license = `{"customer":"Changed","expireDate":"2999-11-29"}`
+	actual, err := testObject.LicenseInfo(ctx)
+	testError(test, ctx, err)
+	assert.Equal(test, "Changed", actual.Customer, "LicenseInfo must not cache the license")
+}
+
+func TestSdkAbstractFactoryImpl_License(test *testing.T) {
+	ctx := context.TODO()
+	g2product := &licenseG2product{
+		license: `{"customer":"Senzing Public Test License","issueDate":"2022-11-29","expireDate":"2999-11-29","recordLimit":50000}`,
+	}
+	testObject := getTestObjectWithG2product(g2product)
+	actual, err := testObject.License(ctx)
+	testError(test, ctx, err)
+	assert.Equal(test, "Senzing Public Test License", actual.Customer)
+	assert.Equal(test, time.Date(2999, time.November, 29, 0, 0, 0, 0, time.UTC), actual.Expiration)
+	assert.False(test, actual.Expired)
+
+	g2product.license = `{"customer":"Changed","expireDate":"2999-11-29"}`
+	actual, err = testObject.License(ctx)
+	testError(test, ctx, err)
+	assert.Equal(test, "Senzing Public Test License", actual.Customer, "the license must come from the cache")
+	testError(test, ctx, WithLicenseCacheTTL(0)(testObject))
+	actual, err = testObject.License(ctx)
+	testError(test, ctx, err)
+	assert.Equal(test, "Changed", actual.Customer, "a zero TTL must disable the cache")
+}
+
+func TestSdkAbstractFactoryImpl_License_expired(test *testing.T) {
+	ctx := context.TODO()
+	testObject := getTestObjectWithG2product(&licenseG2product{
+		license: `{"customer":"Senzing Public Test License","expireDate":"2023-11-29","recordLimit":50000}`,
+	})
+	actual, err := testObject.License(ctx)
+	assert.ErrorIs(test, err, ErrLicenseExpired)
+	assert.ErrorContains(test, err, "2023-11-29")
+	assert.NotNil(test, actual, "an expired license is still returned")
+	assert.True(test, actual.Expired)
+	assert.Equal(test, "Senzing Public Test License", actual.Customer)
+}
+
+func TestSdkAbstractFactoryImpl_License_malformed(test *testing.T) {
+	ctx := context.TODO()
+	testObject := getTestObjectWithG2product(&licenseG2product{
+		license: `{"expireDate":"11/29/2023"}`,
+	})
+	actual, err := testObject.License(ctx)
+	assert.ErrorContains(test, err, "expireDate")
+	assert.NotErrorIs(test, err, ErrLicenseExpired)
+	assert.Nil(test, actual)
+}
+
+func TestSdkAbstractFactoryImpl_LicenseInfo_expired(test *testing.T) {
+	ctx := context.TODO()
+	testObject := getTestObjectWithG2product(&licenseG2product{
+		license: `{"customer":"Senzing Public Test License","expireDate":"2023-11-29","recordLimit":50000}`,
+	})
+	actual, err := testObject.LicenseInfo(ctx)
+	testError(test, ctx, err)
+	assert.True(test, actual.Expired)
+	assert.Equal(test, "Senzing Public Test License", actual.Customer)
+	assert.ErrorIs(test, actual.Err(), ErrLicenseExpired)
+	assert.ErrorContains(test, actual.Err(), "2023-11-29")
+}
+
+func TestWithLicenseCacheTTL_invalid(test *testing.T) {
+	assert.Error(test, WithLicenseCacheTTL(-time.Second)(&SdkAbstractFactoryImpl{}))
+}
+
+func TestSdkAbstractFactoryImpl_Initialize_licenseExpired(test *testing.T) {
+	ctx := context.TODO()
+	g2product := &licenseG2product{license: `{"customer":"Senzing Public Test License","expireDate":"2024-02-01"}`}
+	testObject, logger, anObserver := getTestObjectForLicenseCheck(test, g2product)
+	testError(test, ctx, testObject.Initialize(ctx))
+	assert.Contains(test, logger.messageNumbers, 3005)
+	assert.Equal(test, 1, anObserver.countMessageId(test, "8008"))
+	assert.Contains(test, anObserver.messages[len(anObserver.messages)-1], `"expireDate":"2024-02-01"`)
+
+	testObject, _, _ = getTestObjectForLicenseCheck(test, g2product, WithStrictLicense())
+	assert.ErrorIs(test, testObject.Initialize(ctx), ErrLicenseExpired)
+}
+
+func TestSdkAbstractFactoryImpl_Initialize_licenseExpiresSoon(test *testing.T) {
+	ctx := context.TODO()
+	g2product := &licenseG2product{license: `{"customer":"Senzing Public Test License","expireDate":"2024-03-15"}`}
+	testObject, logger, anObserver := getTestObjectForLicenseCheck(test, g2product, WithStrictLicense())
+	testError(test, ctx, testObject.Initialize(ctx))
+	assert.Contains(test, logger.messageNumbers, 3006)
+	assert.Equal(test, 1, anObserver.countMessageId(test, "8009"))
+	assert.Equal(test, 0, anObserver.countMessageId(test, "8008"))
+}
+
+func TestSdkAbstractFactoryImpl_Initialize_licenseValid(test *testing.T) {
+	ctx := context.TODO()
+	g2product := &licenseG2product{license: `{"customer":"Senzing Public Test License","expireDate":"2025-03-01"}`}
+	testObject, logger, anObserver := getTestObjectForLicenseCheck(test, g2product, WithStrictLicense())
+	testError(test, ctx, testObject.Initialize(ctx))
+	assert.NotContains(test, logger.messageNumbers, 3005)
+	assert.NotContains(test, logger.messageNumbers, 3006)
+	assert.Equal(test, 0, anObserver.countMessageId(test, "8008"))
+	assert.Equal(test, 0, anObserver.countMessageId(test, "8009"))
+}
+
+func TestSdkAbstractFactoryImpl_Initialize_licenseUnreadable(test *testing.T) {
+	ctx := context.TODO()
+	licenseErr := errors.New("license unavailable")
+	g2product := &licenseG2product{err: licenseErr}
+	testObject, logger, _ := getTestObjectForLicenseCheck(test, g2product)
+	testError(test, ctx, testObject.Initialize(ctx))
+	assert.Contains(test, logger.messageNumbers, 3007)
+
+	testObject, _, _ = getTestObjectForLicenseCheck(test, g2product, WithStrictLicense())
+	assert.ErrorIs(test, testObject.Initialize(ctx), licenseErr)
+}
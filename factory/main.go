@@ -2,8 +2,12 @@ package factory
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"github.com/senzing/g2-sdk-go/g2api"
+	"github.com/senzing/go-observing/observer"
+	"google.golang.org/grpc"
 )
 
 // ----------------------------------------------------------------------------
@@ -11,7 +15,9 @@ import (
 // ----------------------------------------------------------------------------
 
 // The SdkAbstractFactory interface shows what Senzing objects that can be retrieved from the abstract factory.
+// The interfaces that follow it are optional: factories may implement any of them.
 type SdkAbstractFactory interface {
+	Destroy(ctx context.Context) error
 	GetG2config(ctx context.Context) (g2api.G2config, error)
 	GetG2configmgr(ctx context.Context) (g2api.G2configmgr, error)
 	GetG2diagnostic(ctx context.Context) (g2api.G2diagnostic, error)
@@ -19,6 +25,83 @@ type SdkAbstractFactory interface {
 	GetG2product(ctx context.Context) (g2api.G2product, error)
 }
 
+// The ConfigManager interface shows how a factory inspects and seeds the Senzing configuration.
+type ConfigManager interface {
+	ActiveConfigID(ctx context.Context) (int64, error)
+	EnsureDefaultConfig(ctx context.Context, configComments string) error
+}
+
+// The HealthChecker interface shows how a factory checks the Senzing backend it uses.
+type HealthChecker interface {
+	CheckCompatibility(ctx context.Context) error
+	HealthCheck(ctx context.Context) error
+	VerifyGrpcServices(ctx context.Context) error
+	WaitUntilReady(ctx context.Context, timeout time.Duration) error
+}
+
+// The ModeManager interface shows how a factory reports and switches the implementations it returns.
+type ModeManager interface {
+	GrpcConnection(ctx context.Context) (*grpc.ClientConn, error)
+	IsGrpc() bool
+	Mode() FactoryMode
+	SetMode(ctx context.Context, mode FactoryMode) error
+	Validate() error
+}
+
+// The ObjectManager interface shows how a factory creates, lists, and discards its Senzing objects.
+type ObjectManager interface {
+	CreatedObjects(ctx context.Context) []interface{}
+	DestroyG2config(ctx context.Context) error
+	DestroyG2configmgr(ctx context.Context) error
+	DestroyG2diagnostic(ctx context.Context) error
+	DestroyG2engine(ctx context.Context) error
+	DestroyG2product(ctx context.Context) error
+	GetAll(ctx context.Context) (*G2Objects, error)
+	GetG2configForConfigID(ctx context.Context, configID int64) (g2api.G2config, uintptr, error)
+	GetG2configWithLoad(ctx context.Context, configID int64) (g2api.G2config, uintptr, error)
+	GetG2engineWithConfigID(ctx context.Context, configID int64) (g2api.G2engine, error)
+	Initialize(ctx context.Context) error
+	OnClose(hook func(ctx context.Context) error)
+	Reset(ctx context.Context, destroy bool) error
+}
+
+// The ObserverRegistry interface shows how a factory notifies observers of Senzing object events.
+type ObserverRegistry interface {
+	RegisterObserver(ctx context.Context, observer observer.Observer) error
+	UnregisterObserver(ctx context.Context, observer observer.Observer) error
+}
+
+// The RecordProcessor interface shows the factory's convenience methods for records.
+type RecordProcessor interface {
+	AddRecord(ctx context.Context, dataSource string, recordID string, jsonData string, flags Flags) (*AddRecordResult, error)
+	ExportEntities(ctx context.Context, flags Flags) (io.ReadCloser, error)
+	ProcessRedoRecords(ctx context.Context, maxRecords int, withInfo bool) (int, []string, error)
+}
+
+// The StatsReporter interface shows the factory's convenience methods for reporting on the Senzing backend.
+type StatsReporter interface {
+	DebugInfo(ctx context.Context) (DebugInfo, error)
+	DiagnosticStats(ctx context.Context) (DiagnosticStats, error)
+	EngineStats(ctx context.Context) (string, error)
+	EngineStatsParsed(ctx context.Context) (EngineStats, error)
+	License(ctx context.Context) (*License, error)
+	LicenseInfo(ctx context.Context) (LicenseInfo, error)
+}
+
+// The ManagedSdkAbstractFactory interface combines SdkAbstractFactory with every optional interface.
+// SdkAbstractFactoryImpl and MultiplexSdkAbstractFactory implement it, and MultiplexSdkAbstractFactory
+// requires it of its Primary and Secondaries.
+type ManagedSdkAbstractFactory interface {
+	SdkAbstractFactory
+	ConfigManager
+	HealthChecker
+	ModeManager
+	ObjectManager
+	ObserverRegistry
+	RecordProcessor
+	StatsReporter
+}
+
 // ----------------------------------------------------------------------------
 // Constants
 // ----------------------------------------------------------------------------
@@ -26,6 +109,13 @@ type SdkAbstractFactory interface {
 // Identfier of the factory package found messages having the format "senzing-6041xxxx".
 const ProductId = 6041
 
+// Largest message number in IdMessages.  The factory reserves the message identifiers
+// senzing-60410000 through senzing-60419999; see docs/errors.md.
+const MaxMessageNumber = 9999
+
+// Format of the factory's message identifiers, given a message number.
+const MessageIdTemplate = "senzing-6041%04d"
+
 // ----------------------------------------------------------------------------
 // Variables
 // ----------------------------------------------------------------------------
@@ -34,12 +124,34 @@ const ProductId = 6041
 var IdMessages = map[int]string{
 	1:    "Enter AddDataSource(%v, %s).",
 	2:    "Exit  AddDataSource(%v, %s) returned (%s, %v).",
+	2001: "Created %s using the local Senzing Go SDK",
+	2002: "Created %s using the Senzing gRPC server at %s",
+	2003: "Created %s using the null backend; calls have no effect",
+	2004: "Waiting up to %s for %d in-flight gRPC calls before closing the connection",
+	2005: "Destroying factory objects because the base context is done: %v",
+	3001: "A nil context.Context was passed to the factory; using context.Background()",
+	3002: "Closing the gRPC connection with %d gRPC calls still in flight",
+	3003: "Dropped a notification for observer %s; its buffer of %d notifications is full",
+	3004: "PURGING THE SENZING REPOSITORY in %s mode: every record and entity is being deleted, as WithPurgeOnInit requests",
+	3005: "The Senzing license of %s expired on %s; Senzing calls may fail until it is renewed",
+	3006: "The Senzing license of %s expires on %s",
+	3007: "Cannot check the Senzing license: %v",
 	4001: "Cannot G2Config.Init()",
 	4002: "Cannot G2Configmgr.Init()",
 	4003: "Cannot G2Diagnostic.Init()",
 	4004: "Cannot G2Engine.Init()",
 	4005: "Cannot G2Product.Init()",
 	4010: "Did not make a gRPC connection",
+	4011: "Ignored invalid SENZING_GRPC_* environment variables",
+	8001: "Created G2config",
+	8002: "Created G2configmgr",
+	8003: "Created G2diagnostic",
+	8004: "Created G2engine",
+	8005: "Created G2product",
+	8006: "Destroyed factory objects",
+	8007: "Reset factory",
+	8008: "Senzing license expired",
+	8009: "Senzing license expires soon",
 }
 
 // Status strings for specific factory messages.
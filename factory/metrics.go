@@ -0,0 +1,109 @@
+package factory
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+MetricsRecorder receives the measurements a factory reports; see WithMetrics.
+The factoryprometheus package records them in Prometheus collectors, so that the factory
+package itself does not depend on Prometheus.  The methods must not block.
+*/
+type MetricsRecorder interface {
+	// GrpcConnectionStateChanged reports the current state of the factory's gRPC connection.
+	GrpcConnectionStateChanged(state connectivity.State)
+	// ObjectCreated counts a Senzing object created by a GetG2* method in mode.
+	ObjectCreated(objectName string, mode FactoryMode)
+	// ObserverNotificationDropped counts a notification dropped because the buffer of the
+	// observer identified by observerId was full; see WithObserverBuffer.
+	ObserverNotificationDropped(observerId string)
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const (
+	// otelMeterName is the name of the OpenTelemetry meter of a factory with OtelMeterProvider.
+	otelMeterName = "github.com/senzing/go-sdk-abstract-factory/factory"
+	// otelObjectsCreatedName is the OpenTelemetry counter of the objects created by the GetG2* methods.
+	otelObjectsCreatedName = "senzing.factory.objects.created"
+)
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Return the OpenTelemetry counter of created objects, creating it on first use, or nil if
+// OtelMeterProvider is unset or the counter cannot be created.
+func (factory *SdkAbstractFactoryImpl) getOtelObjectsCreated() metric.Int64Counter {
+	factory.otelCounterOnce.Do(func() {
+		if factory.OtelMeterProvider == nil {
+			return
+		}
+		counter, err := factory.OtelMeterProvider.Meter(otelMeterName).Int64Counter(otelObjectsCreatedName,
+			metric.WithDescription("Senzing objects created by the GetG2* methods."),
+			metric.WithUnit("{object}"),
+		)
+		if err == nil {
+			factory.otelObjectsCreated = counter
+		}
+	})
+	return factory.otelObjectsCreated
+}
+
+// Report a newly created Senzing object to the log and, if configured, to Metrics and OtelMeterProvider.
+// Called by the GetG2* methods, which hold modeMutex, so the mode is read without locking.
+func (factory *SdkAbstractFactoryImpl) recordCreation(objectName string) {
+	factory.logBackend(objectName)
+	if factory.Metrics != nil {
+		factory.Metrics.ObjectCreated(objectName, factory.mode())
+	}
+	if counter := factory.getOtelObjectsCreated(); counter != nil {
+		counter.Add(context.Background(), 1, metric.WithAttributes(
+			attribute.String("object", objectName),
+			attribute.String("mode", factory.mode().String()),
+		))
+	}
+}
+
+// If Metrics or GrpcConnectionStateCallback is configured, report the state of grpcConnection
+// from a goroutine that runs until the connection shuts down or reset stops it.
+func (factory *SdkAbstractFactoryImpl) watchGrpcConnectionState(grpcConnection *grpc.ClientConn) {
+	metrics := factory.Metrics
+	callback := factory.GrpcConnectionStateCallback
+	if metrics == nil && callback == nil {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	factory.grpcStateWatchStop = func() {
+		cancel()
+		<-done
+	}
+	go func() {
+		defer close(done)
+		state := grpcConnection.GetState()
+		for {
+			if metrics != nil {
+				metrics.GrpcConnectionStateChanged(state)
+			}
+			if callback != nil {
+				callback(state)
+			}
+			if state == connectivity.Shutdown || !grpcConnection.WaitForStateChange(ctx, state) {
+				return
+			}
+			state = grpcConnection.GetState()
+		}
+	}()
+}
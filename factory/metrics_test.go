@@ -0,0 +1,210 @@
+package factory
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// ----------------------------------------------------------------------------
+// Test doubles
+// ----------------------------------------------------------------------------
+
+// recordingMetrics counts the measurements reported to it by kind and labels.
+type recordingMetrics struct {
+	counts map[string]int
+	mutex  sync.Mutex
+}
+
+func (metrics *recordingMetrics) add(labels ...string) {
+	metrics.mutex.Lock()
+	defer metrics.mutex.Unlock()
+	if metrics.counts == nil {
+		metrics.counts = map[string]int{}
+	}
+	metrics.counts[strings.Join(labels, "/")]++
+}
+
+func (metrics *recordingMetrics) count(labels ...string) int {
+	metrics.mutex.Lock()
+	defer metrics.mutex.Unlock()
+	return metrics.counts[strings.Join(labels, "/")]
+}
+
+func (metrics *recordingMetrics) GrpcConnectionStateChanged(state connectivity.State) {
+	metrics.add("state", state.String())
+}
+
+func (metrics *recordingMetrics) ObjectCreated(objectName string, mode FactoryMode) {
+	metrics.add("created", objectName, mode.String())
+}
+
+func (metrics *recordingMetrics) ObserverNotificationDropped(observerId string) {
+	metrics.add("dropped", observerId)
+}
+
+// recordingMeterProvider counts the meters requested from it and the additions to their
+// Int64Counters, labeled by counter name and attribute values in key order.
+type recordingMeterProvider struct {
+	noop.MeterProvider
+	metrics recordingMetrics
+}
+
+func (provider *recordingMeterProvider) Meter(name string, options ...metric.MeterOption) metric.Meter {
+	provider.metrics.add("meter", name)
+	return recordingMeter{metrics: &provider.metrics}
+}
+
+type recordingMeter struct {
+	noop.Meter
+	metrics *recordingMetrics
+}
+
+func (meter recordingMeter) Int64Counter(name string, options ...metric.Int64CounterOption) (metric.Int64Counter, error) {
+	return recordingInt64Counter{metrics: meter.metrics, name: name}, nil
+}
+
+type recordingInt64Counter struct {
+	noop.Int64Counter
+	metrics *recordingMetrics
+	name    string
+}
+
+func (counter recordingInt64Counter) Add(ctx context.Context, incr int64, options ...metric.AddOption) {
+	labels := []string{counter.name}
+	attributes := metric.NewAddConfig(options).Attributes()
+	for _, keyValue := range attributes.ToSlice() {
+		labels = append(labels, keyValue.Value.Emit())
+	}
+	for i := int64(0); i < incr; i++ {
+		counter.metrics.add(labels...)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestWithMetrics(test *testing.T) {
+	ctx := context.TODO()
+	metrics := &recordingMetrics{}
+	testObject, err := New(WithGrpcAddress(startTestGrpcServer(test)), WithMetrics(metrics))
+	testError(test, ctx, err)
+	defer testObject.Destroy(ctx)
+	_, err = testObject.GetG2engine(ctx)
+	testError(test, ctx, err)
+	_, err = testObject.GetG2engine(ctx)
+	testError(test, ctx, err)
+	assert.Equal(test, 1, metrics.count("created", "G2engine", "grpc"))
+
+	err = checkTestGrpcConnection(ctx, testObject)
+	testError(test, ctx, err)
+	assert.Eventually(test, func() bool {
+		return metrics.count("state", "READY") > 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestWithMetrics_nil(test *testing.T) {
+	_, err := New(WithMetrics(nil))
+	assert.Error(test, err)
+}
+
+func TestWithOtelMeterProvider(test *testing.T) {
+	ctx := context.TODO()
+	provider := &recordingMeterProvider{}
+	testObject, err := New(WithGrpcAddress(startTestGrpcServer(test)), WithOtelMeterProvider(provider))
+	testError(test, ctx, err)
+	defer testObject.Destroy(ctx)
+	assert.Len(test, testObject.(*SdkAbstractFactoryImpl).GrpcStatsHandlers, 1)
+	_, err = testObject.GetG2engine(ctx)
+	testError(test, ctx, err)
+	_, err = testObject.GetG2engine(ctx)
+	testError(test, ctx, err)
+	_, err = testObject.GetG2product(ctx)
+	testError(test, ctx, err)
+	assert.Equal(test, 1, provider.metrics.count(otelObjectsCreatedName, "grpc", "G2engine"))
+	assert.Equal(test, 1, provider.metrics.count(otelObjectsCreatedName, "grpc", "G2product"))
+	assert.Equal(test, 1, provider.metrics.count("meter", otelMeterName), "the counter is created once")
+
+	err = checkTestGrpcConnection(ctx, testObject)
+	testError(test, ctx, err)
+	assert.Equal(test, 1, provider.metrics.count("meter", "go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"))
+}
+
+func TestWithOtelMeterProvider_clone(test *testing.T) {
+	ctx := context.TODO()
+	provider := &recordingMeterProvider{}
+	testObject, err := New(WithNullBackend(), WithOtelMeterProvider(provider))
+	testError(test, ctx, err)
+	clone, err := testObject.(*SdkAbstractFactoryImpl).Clone()
+	testError(test, ctx, err)
+	_, err = testObject.GetG2config(ctx)
+	testError(test, ctx, err)
+	_, err = clone.GetG2config(ctx)
+	testError(test, ctx, err)
+	assert.Equal(test, 2, provider.metrics.count(otelObjectsCreatedName, "null", "G2config"))
+}
+
+func TestWithOtelMeterProvider_nil(test *testing.T) {
+	_, err := New(WithOtelMeterProvider(nil))
+	assert.Error(test, err)
+}
+
+func TestWithConnectionStateCallback(test *testing.T) {
+	ctx := context.TODO()
+	listener, err := net.Listen("tcp", "localhost:0")
+	testError(test, ctx, err)
+	server := grpc.NewServer()
+	grpc_health_v1.RegisterHealthServer(server, health.NewServer())
+	go server.Serve(listener)
+
+	var mutex sync.Mutex
+	states := []connectivity.State{}
+	hasState := func(expected connectivity.State) bool {
+		mutex.Lock()
+		defer mutex.Unlock()
+		for _, state := range states {
+			if state == expected {
+				return true
+			}
+		}
+		return false
+	}
+	testObject, err := New(
+		WithGrpcAddress(listener.Addr().String()),
+		WithConnectionStateCallback(func(state connectivity.State) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			states = append(states, state)
+		}),
+	)
+	testError(test, ctx, err)
+	err = checkTestGrpcConnection(ctx, testObject)
+	testError(test, ctx, err)
+	assert.Eventually(test, func() bool { return hasState(connectivity.Ready) }, 5*time.Second, 10*time.Millisecond)
+
+	server.Stop()
+	assert.Eventually(test, func() bool {
+		return hasState(connectivity.Idle) || hasState(connectivity.TransientFailure)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	testError(test, ctx, testObject.Destroy(ctx))
+	mutex.Lock()
+	count := len(states)
+	mutex.Unlock()
+	time.Sleep(50 * time.Millisecond)
+	mutex.Lock()
+	defer mutex.Unlock()
+	assert.Len(test, states, count, "no callbacks after Destroy")
+}
@@ -0,0 +1,172 @@
+package factory
+
+import (
+	"context"
+	"fmt"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// FactoryMode identifies which implementations of the Senzing objects a factory returns.
+type FactoryMode int
+
+// factoryModeContextKey is the context key for the FactoryMode set by ContextWithFactoryMode.
+type factoryModeContextKey struct{}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const (
+	// ModeLocal factories return implementations that use a local Senzing Go SDK.
+	ModeLocal FactoryMode = iota
+	// ModeGrpc factories return implementations that communicate over gRPC.
+	ModeGrpc
+	// ModeNull factories return no-op implementations for dry runs; see WithNullBackend.
+	ModeNull
+)
+
+// ----------------------------------------------------------------------------
+// Functions
+// ----------------------------------------------------------------------------
+
+// ContextWithFactoryMode returns a copy of ctx carrying mode, for code that needs to know whether
+// it talks to a local or remote Senzing without being passed the factory.
+func ContextWithFactoryMode(ctx context.Context, mode FactoryMode) context.Context {
+	return context.WithValue(ctx, factoryModeContextKey{}, mode)
+}
+
+// FactoryModeFromContext returns the FactoryMode carried by ctx, and false if there is none.
+func FactoryModeFromContext(ctx context.Context) (FactoryMode, bool) {
+	mode, ok := ctx.Value(factoryModeContextKey{}).(FactoryMode)
+	return mode, ok
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// IsGrpc without locking modeMutex, for callers that hold it.
+func (factory *SdkAbstractFactoryImpl) isGrpc() bool {
+	if factory.NullBackend || factory.LocalBackend {
+		return false
+	}
+	return len(factory.GrpcAddress) > 0 || factory.GrpcDialer != nil || factory.GrpcSharedConnection != nil
+}
+
+// Mode without locking modeMutex, for callers that hold it.
+func (factory *SdkAbstractFactoryImpl) mode() FactoryMode {
+	if factory.NullBackend {
+		return ModeNull
+	}
+	if factory.isGrpc() {
+		return ModeGrpc
+	}
+	return ModeLocal
+}
+
+// ----------------------------------------------------------------------------
+// Interface methods
+// ----------------------------------------------------------------------------
+
+// String returns "local", "grpc", or "null".
+func (mode FactoryMode) String() string {
+	switch mode {
+	case ModeLocal:
+		return "local"
+	case ModeGrpc:
+		return "grpc"
+	case ModeNull:
+		return "null"
+	}
+	return "unknown"
+}
+
+/*
+The IsGrpc method reports whether the factory returns implementations that communicate over gRPC.
+It is true when GrpcAddress is set or a gRPC connection or dialer is provided,
+unless NullBackend or LocalBackend is set.
+It creates no objects, so it may be called before any GetG2* method.
+
+Output
+  - True for ModeGrpc.
+*/
+func (factory *SdkAbstractFactoryImpl) IsGrpc() bool {
+	factory.modeMutex.RLock()
+	defer factory.modeMutex.RUnlock()
+	return factory.isGrpc()
+}
+
+/*
+The Mode method returns which implementations of the Senzing objects the factory returns.
+It creates no objects, so it may be called before any GetG2* method.
+
+Output
+  - ModeNull, ModeGrpc, or ModeLocal.
+*/
+func (factory *SdkAbstractFactoryImpl) Mode() FactoryMode {
+	factory.modeMutex.RLock()
+	defer factory.modeMutex.RUnlock()
+	return factory.mode()
+}
+
+/*
+The ContextWithMode method stamps the factory's mode onto ctx.
+The GetG2* methods never do this themselves; call ContextWithMode where the objects
+are obtained and pass the result down.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - A copy of ctx from which FactoryModeFromContext returns Mode().
+*/
+func (factory *SdkAbstractFactoryImpl) ContextWithMode(ctx context.Context) context.Context {
+	return ContextWithFactoryMode(factory.getContext(ctx), factory.Mode())
+}
+
+/*
+The SetMode method switches the backend used by subsequent GetG2* calls, e.g. to A/B test
+the local and gRPC backends without restarting.  If mode differs from Mode(), the existing
+objects are destroyed, as by Reset with destroy, so objects already returned to callers
+must no longer be used.  GetG2* calls made during the switch wait for it to finish, so each
+returns an object of either the old or the new mode.
+The factory must be configured for every mode it switches to: ModeGrpc needs a gRPC address,
+dialer, or connection, and ModeLocal a valid engine configuration JSON.  Use WithLocalBackend
+to start such a factory in ModeLocal.
+
+Input
+  - ctx: A context to control lifecycle.
+  - mode: ModeLocal, ModeGrpc, or ModeNull.
+
+Output
+  - An error if the factory is not configured for mode, or the errors from destroying the
+    existing objects, in which case the mode is switched nevertheless.
+*/
+func (factory *SdkAbstractFactoryImpl) SetMode(ctx context.Context, mode FactoryMode) error {
+	ctx = factory.getContext(ctx)
+	factory.modeMutex.Lock()
+	defer factory.modeMutex.Unlock()
+	if mode == factory.mode() {
+		return nil
+	}
+	switch mode {
+	case ModeGrpc:
+		if len(factory.GrpcAddress) == 0 && factory.GrpcDialer == nil && factory.GrpcSharedConnection == nil {
+			return fmt.Errorf("cannot switch to %s mode: use WithGrpcAddress, WithGrpcDialer, or WithGrpcConnection", mode)
+		}
+	case ModeLocal:
+		if err := validateEngineConfigurationJson(factory.EngineConfigurationJson); err != nil {
+			return fmt.Errorf("cannot switch to %s mode: %w", mode, err)
+		}
+	case ModeNull:
+	default:
+		return fmt.Errorf("cannot switch to %s mode", mode)
+	}
+	err := factory.resetObjects(ctx, true)
+	factory.LocalBackend = mode == ModeLocal
+	factory.NullBackend = mode == ModeNull
+	return err
+}
@@ -0,0 +1,141 @@
+package factory
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	g2enginegrpc "github.com/senzing/g2-sdk-go-grpc/g2engine"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSdkAbstractFactoryImpl_Mode(test *testing.T) {
+	testCases := []struct {
+		name         string
+		testObject   *SdkAbstractFactoryImpl
+		expected     FactoryMode
+		expectedGrpc bool
+	}{
+		{name: "local", testObject: &SdkAbstractFactoryImpl{EngineConfigurationJson: `{"PIPELINE": {}}`}, expected: ModeLocal},
+		{name: "grpcAddress", testObject: &SdkAbstractFactoryImpl{GrpcAddress: "localhost:8258"}, expected: ModeGrpc, expectedGrpc: true},
+		{name: "localBackend", testObject: &SdkAbstractFactoryImpl{GrpcAddress: "localhost:8258", LocalBackend: true}, expected: ModeLocal},
+		{name: "null", testObject: &SdkAbstractFactoryImpl{GrpcAddress: "localhost:8258", NullBackend: true}, expected: ModeNull},
+		{name: "grpcDialer", testObject: &SdkAbstractFactoryImpl{GrpcDialer: func(ctx context.Context) (*grpc.ClientConn, error) { return nil, nil }}, expected: ModeGrpc, expectedGrpc: true},
+	}
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func(test *testing.T) {
+			assert.Equal(test, testCase.expected, testCase.testObject.Mode())
+			assert.Equal(test, testCase.expectedGrpc, testCase.testObject.IsGrpc())
+			assert.Empty(test, testCase.testObject.CreatedObjects(context.TODO()))
+		})
+	}
+}
+
+func TestFactoryMode_String(test *testing.T) {
+	assert.Equal(test, "local", ModeLocal.String())
+	assert.Equal(test, "grpc", ModeGrpc.String())
+	assert.Equal(test, "null", ModeNull.String())
+}
+
+func TestContextWithFactoryMode(test *testing.T) {
+	for _, mode := range []FactoryMode{ModeLocal, ModeGrpc, ModeNull} {
+		actual, ok := FactoryModeFromContext(ContextWithFactoryMode(context.TODO(), mode))
+		assert.True(test, ok)
+		assert.Equal(test, mode, actual)
+	}
+}
+
+func TestFactoryModeFromContext_missing(test *testing.T) {
+	actual, ok := FactoryModeFromContext(context.TODO())
+	assert.False(test, ok)
+	assert.Equal(test, ModeLocal, actual)
+}
+
+func TestSdkAbstractFactoryImpl_ContextWithMode(test *testing.T) {
+	testObject := &SdkAbstractFactoryImpl{GrpcAddress: "localhost:8258"}
+	actual, ok := FactoryModeFromContext(testObject.ContextWithMode(context.TODO()))
+	assert.True(test, ok)
+	assert.Equal(test, ModeGrpc, actual)
+}
+
+func TestSdkAbstractFactoryImpl_SetMode(test *testing.T) {
+	ctx := context.TODO()
+	localG2engine := &destroyG2engine{}
+	testObject := getTestObjectWithG2engine(localG2engine)
+	testObject.EngineConfigurationJson = `{"PIPELINE": {"CONFIGPATH": "/etc/opt/senzing", "RESOURCEPATH": "/opt/senzing/g2/resources", "SUPPORTPATH": "/opt/senzing/data"}, "SQL": {"CONNECTION": "sqlite3://na:na@/tmp/sqlite/G2C.db"}}`
+	testObject.GrpcAddress = startTestGrpcServer(test)
+	testError(test, ctx, WithLocalBackend()(testObject))
+	defer testObject.Destroy(ctx)
+	assert.Equal(test, ModeLocal, testObject.Mode())
+	g2engine, err := testObject.GetG2engine(ctx)
+	testError(test, ctx, err)
+	assert.Same(test, localG2engine, g2engine)
+
+	testError(test, ctx, testObject.SetMode(ctx, ModeGrpc))
+	assert.Equal(test, ModeGrpc, testObject.Mode())
+	assert.Equal(test, 1, localG2engine.destroyCount)
+	g2engine, err = testObject.GetG2engine(ctx)
+	testError(test, ctx, err)
+	assert.IsType(test, &g2enginegrpc.G2engine{}, g2engine)
+
+	testError(test, ctx, testObject.SetMode(ctx, ModeGrpc))
+	actual, err := testObject.GetG2engine(ctx)
+	testError(test, ctx, err)
+	assert.Same(test, g2engine, actual, "setting the current mode must keep the objects")
+}
+
+func TestSdkAbstractFactoryImpl_SetMode_unconfigured(test *testing.T) {
+	ctx := context.TODO()
+	testObject := &SdkAbstractFactoryImpl{GrpcAddress: "localhost:8258"}
+	assert.ErrorIs(test, testObject.SetMode(ctx, ModeLocal), ErrInvalidEngineConfiguration)
+	assert.Equal(test, ModeGrpc, testObject.Mode())
+	testObject = &SdkAbstractFactoryImpl{EngineConfigurationJson: `{"PIPELINE": {}}`}
+	assert.Error(test, testObject.SetMode(ctx, ModeGrpc))
+	assert.Equal(test, ModeLocal, testObject.Mode())
+	assert.Error(test, testObject.SetMode(ctx, FactoryMode(42)))
+}
+
+func TestSdkAbstractFactoryImpl_SetMode_concurrentGetters(test *testing.T) {
+	ctx := context.TODO()
+	testObject := &SdkAbstractFactoryImpl{GrpcAddress: startTestGrpcServer(test)}
+	defer testObject.Destroy(ctx)
+	var waitGroup sync.WaitGroup
+	done := make(chan struct{})
+	for i := 0; i < 10; i++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				g2engine, err := testObject.GetG2engine(ctx)
+				if !assert.NoError(test, err) {
+					return
+				}
+				switch g2engine.(type) {
+				case *nullG2engine, *g2enginegrpc.G2engine:
+				default:
+					test.Errorf("GetG2engine returned a %T", g2engine)
+					return
+				}
+			}
+		}()
+	}
+	for i := 0; i < 20; i++ {
+		mode := ModeNull
+		if i%2 == 1 {
+			mode = ModeGrpc
+		}
+		testError(test, ctx, testObject.SetMode(ctx, mode))
+	}
+	close(done)
+	waitGroup.Wait()
+}
@@ -0,0 +1,879 @@
+package factory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/senzing/g2-sdk-go/g2api"
+	"github.com/senzing/go-observing/observer"
+	"google.golang.org/grpc"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+MultiplexSdkAbstractFactory spreads G2engine read calls across several independent
+backend factories while pinning everything else to a designated primary.
+
+G2engine read methods round-robin across Primary and Secondaries: CheckRecord,
+FindInterestingEntities*, FindNetwork*, FindPath*, GetEntityBy*, GetRecord,
+GetVirtualEntityByRecordID, HowEntityByEntityID, SearchByAttributes, and Why*,
+including their _V2 variants.
+All other G2engine methods, including writes, redo, configuration, statistics and
+export cursors, and all other Senzing objects come from Primary.
+*/
+type MultiplexSdkAbstractFactory struct {
+	g2engineMutex     sync.RWMutex
+	g2engineSingleton *multiplexG2engine
+	g2engineSyncOnce  successOnce
+	Primary           ManagedSdkAbstractFactory
+	Secondaries       []ManagedSdkAbstractFactory
+}
+
+// multiplexG2engine delegates writes to the embedded primary engine and rotates reads across readers.
+type multiplexG2engine struct {
+	g2api.G2engine
+	next    uint64
+	readers []g2api.G2engine
+}
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var _ ManagedSdkAbstractFactory = (*MultiplexSdkAbstractFactory)(nil)
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Forget the multiplexed G2engine, so the next GetG2engine call builds a new one from the backends.
+// Called with g2engineMutex write-locked.
+func (factory *MultiplexSdkAbstractFactory) resetG2engine() {
+	factory.g2engineSingleton = nil
+	factory.g2engineSyncOnce = successOnce{}
+}
+
+// Choose the next engine for a read call.
+func (g2engine *multiplexG2engine) reader() g2api.G2engine {
+	next := atomic.AddUint64(&g2engine.next, 1) - 1
+	return g2engine.readers[next%uint64(len(g2engine.readers))]
+}
+
+// ----------------------------------------------------------------------------
+// G2engine read methods
+// ----------------------------------------------------------------------------
+
+func (g2engine *multiplexG2engine) CheckRecord(ctx context.Context, record string, recordQueryList string) (string, error) {
+	return g2engine.reader().CheckRecord(ctx, record, recordQueryList)
+}
+
+func (g2engine *multiplexG2engine) FindInterestingEntitiesByEntityID(ctx context.Context, entityID int64, flags int64) (string, error) {
+	return g2engine.reader().FindInterestingEntitiesByEntityID(ctx, entityID, flags)
+}
+
+func (g2engine *multiplexG2engine) FindInterestingEntitiesByRecordID(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	return g2engine.reader().FindInterestingEntitiesByRecordID(ctx, dataSourceCode, recordID, flags)
+}
+
+func (g2engine *multiplexG2engine) FindNetworkByEntityID(ctx context.Context, entityList string, maxDegree int, buildOutDegree int, maxEntities int) (string, error) {
+	return g2engine.reader().FindNetworkByEntityID(ctx, entityList, maxDegree, buildOutDegree, maxEntities)
+}
+
+func (g2engine *multiplexG2engine) FindNetworkByEntityID_V2(ctx context.Context, entityList string, maxDegree int, buildOutDegree int, maxEntities int, flags int64) (string, error) {
+	return g2engine.reader().FindNetworkByEntityID_V2(ctx, entityList, maxDegree, buildOutDegree, maxEntities, flags)
+}
+
+func (g2engine *multiplexG2engine) FindNetworkByRecordID(ctx context.Context, recordList string, maxDegree int, buildOutDegree int, maxEntities int) (string, error) {
+	return g2engine.reader().FindNetworkByRecordID(ctx, recordList, maxDegree, buildOutDegree, maxEntities)
+}
+
+func (g2engine *multiplexG2engine) FindNetworkByRecordID_V2(ctx context.Context, recordList string, maxDegree int, buildOutDegree int, maxEntities int, flags int64) (string, error) {
+	return g2engine.reader().FindNetworkByRecordID_V2(ctx, recordList, maxDegree, buildOutDegree, maxEntities, flags)
+}
+
+func (g2engine *multiplexG2engine) FindPathByEntityID(ctx context.Context, entityID1 int64, entityID2 int64, maxDegree int) (string, error) {
+	return g2engine.reader().FindPathByEntityID(ctx, entityID1, entityID2, maxDegree)
+}
+
+func (g2engine *multiplexG2engine) FindPathByEntityID_V2(ctx context.Context, entityID1 int64, entityID2 int64, maxDegree int, flags int64) (string, error) {
+	return g2engine.reader().FindPathByEntityID_V2(ctx, entityID1, entityID2, maxDegree, flags)
+}
+
+func (g2engine *multiplexG2engine) FindPathByRecordID(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, maxDegree int) (string, error) {
+	return g2engine.reader().FindPathByRecordID(ctx, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree)
+}
+
+func (g2engine *multiplexG2engine) FindPathByRecordID_V2(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, maxDegree int, flags int64) (string, error) {
+	return g2engine.reader().FindPathByRecordID_V2(ctx, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, flags)
+}
+
+func (g2engine *multiplexG2engine) FindPathExcludingByEntityID(ctx context.Context, entityID1 int64, entityID2 int64, maxDegree int, excludedEntities string) (string, error) {
+	return g2engine.reader().FindPathExcludingByEntityID(ctx, entityID1, entityID2, maxDegree, excludedEntities)
+}
+
+func (g2engine *multiplexG2engine) FindPathExcludingByEntityID_V2(ctx context.Context, entityID1 int64, entityID2 int64, maxDegree int, excludedEntities string, flags int64) (string, error) {
+	return g2engine.reader().FindPathExcludingByEntityID_V2(ctx, entityID1, entityID2, maxDegree, excludedEntities, flags)
+}
+
+func (g2engine *multiplexG2engine) FindPathExcludingByRecordID(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, maxDegree int, excludedRecords string) (string, error) {
+	return g2engine.reader().FindPathExcludingByRecordID(ctx, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords)
+}
+
+func (g2engine *multiplexG2engine) FindPathExcludingByRecordID_V2(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, maxDegree int, excludedRecords string, flags int64) (string, error) {
+	return g2engine.reader().FindPathExcludingByRecordID_V2(ctx, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords, flags)
+}
+
+func (g2engine *multiplexG2engine) FindPathIncludingSourceByEntityID(ctx context.Context, entityID1 int64, entityID2 int64, maxDegree int, excludedEntities string, requiredDsrcs string) (string, error) {
+	return g2engine.reader().FindPathIncludingSourceByEntityID(ctx, entityID1, entityID2, maxDegree, excludedEntities, requiredDsrcs)
+}
+
+func (g2engine *multiplexG2engine) FindPathIncludingSourceByEntityID_V2(ctx context.Context, entityID1 int64, entityID2 int64, maxDegree int, excludedEntities string, requiredDsrcs string, flags int64) (string, error) {
+	return g2engine.reader().FindPathIncludingSourceByEntityID_V2(ctx, entityID1, entityID2, maxDegree, excludedEntities, requiredDsrcs, flags)
+}
+
+func (g2engine *multiplexG2engine) FindPathIncludingSourceByRecordID(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, maxDegree int, excludedRecords string, requiredDsrcs string) (string, error) {
+	return g2engine.reader().FindPathIncludingSourceByRecordID(ctx, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords, requiredDsrcs)
+}
+
+func (g2engine *multiplexG2engine) FindPathIncludingSourceByRecordID_V2(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, maxDegree int, excludedRecords string, requiredDsrcs string, flags int64) (string, error) {
+	return g2engine.reader().FindPathIncludingSourceByRecordID_V2(ctx, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords, requiredDsrcs, flags)
+}
+
+func (g2engine *multiplexG2engine) GetEntityByEntityID(ctx context.Context, entityID int64) (string, error) {
+	return g2engine.reader().GetEntityByEntityID(ctx, entityID)
+}
+
+func (g2engine *multiplexG2engine) GetEntityByEntityID_V2(ctx context.Context, entityID int64, flags int64) (string, error) {
+	return g2engine.reader().GetEntityByEntityID_V2(ctx, entityID, flags)
+}
+
+func (g2engine *multiplexG2engine) GetEntityByRecordID(ctx context.Context, dataSourceCode string, recordID string) (string, error) {
+	return g2engine.reader().GetEntityByRecordID(ctx, dataSourceCode, recordID)
+}
+
+func (g2engine *multiplexG2engine) GetEntityByRecordID_V2(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	return g2engine.reader().GetEntityByRecordID_V2(ctx, dataSourceCode, recordID, flags)
+}
+
+func (g2engine *multiplexG2engine) GetRecord(ctx context.Context, dataSourceCode string, recordID string) (string, error) {
+	return g2engine.reader().GetRecord(ctx, dataSourceCode, recordID)
+}
+
+func (g2engine *multiplexG2engine) GetRecord_V2(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	return g2engine.reader().GetRecord_V2(ctx, dataSourceCode, recordID, flags)
+}
+
+func (g2engine *multiplexG2engine) GetVirtualEntityByRecordID(ctx context.Context, recordList string) (string, error) {
+	return g2engine.reader().GetVirtualEntityByRecordID(ctx, recordList)
+}
+
+func (g2engine *multiplexG2engine) GetVirtualEntityByRecordID_V2(ctx context.Context, recordList string, flags int64) (string, error) {
+	return g2engine.reader().GetVirtualEntityByRecordID_V2(ctx, recordList, flags)
+}
+
+func (g2engine *multiplexG2engine) HowEntityByEntityID(ctx context.Context, entityID int64) (string, error) {
+	return g2engine.reader().HowEntityByEntityID(ctx, entityID)
+}
+
+func (g2engine *multiplexG2engine) HowEntityByEntityID_V2(ctx context.Context, entityID int64, flags int64) (string, error) {
+	return g2engine.reader().HowEntityByEntityID_V2(ctx, entityID, flags)
+}
+
+func (g2engine *multiplexG2engine) SearchByAttributes(ctx context.Context, jsonData string) (string, error) {
+	return g2engine.reader().SearchByAttributes(ctx, jsonData)
+}
+
+func (g2engine *multiplexG2engine) SearchByAttributes_V2(ctx context.Context, jsonData string, flags int64) (string, error) {
+	return g2engine.reader().SearchByAttributes_V2(ctx, jsonData, flags)
+}
+
+func (g2engine *multiplexG2engine) WhyEntities(ctx context.Context, entityID1 int64, entityID2 int64) (string, error) {
+	return g2engine.reader().WhyEntities(ctx, entityID1, entityID2)
+}
+
+func (g2engine *multiplexG2engine) WhyEntities_V2(ctx context.Context, entityID1 int64, entityID2 int64, flags int64) (string, error) {
+	return g2engine.reader().WhyEntities_V2(ctx, entityID1, entityID2, flags)
+}
+
+func (g2engine *multiplexG2engine) WhyEntityByEntityID(ctx context.Context, entityID int64) (string, error) {
+	return g2engine.reader().WhyEntityByEntityID(ctx, entityID)
+}
+
+func (g2engine *multiplexG2engine) WhyEntityByEntityID_V2(ctx context.Context, entityID int64, flags int64) (string, error) {
+	return g2engine.reader().WhyEntityByEntityID_V2(ctx, entityID, flags)
+}
+
+func (g2engine *multiplexG2engine) WhyEntityByRecordID(ctx context.Context, dataSourceCode string, recordID string) (string, error) {
+	return g2engine.reader().WhyEntityByRecordID(ctx, dataSourceCode, recordID)
+}
+
+func (g2engine *multiplexG2engine) WhyEntityByRecordID_V2(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	return g2engine.reader().WhyEntityByRecordID_V2(ctx, dataSourceCode, recordID, flags)
+}
+
+func (g2engine *multiplexG2engine) WhyRecords(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string) (string, error) {
+	return g2engine.reader().WhyRecords(ctx, dataSourceCode1, recordID1, dataSourceCode2, recordID2)
+}
+
+func (g2engine *multiplexG2engine) WhyRecords_V2(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, flags int64) (string, error) {
+	return g2engine.reader().WhyRecords_V2(ctx, dataSourceCode1, recordID1, dataSourceCode2, recordID2, flags)
+}
+
+// ----------------------------------------------------------------------------
+// Interface methods
+// ----------------------------------------------------------------------------
+
+/*
+The ActiveConfigID method returns the active configuration identifier of the Primary factory.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The configuration identifier returned by G2engine.GetActiveConfigID.
+*/
+func (factory *MultiplexSdkAbstractFactory) ActiveConfigID(ctx context.Context) (int64, error) {
+	return factory.Primary.ActiveConfigID(ctx)
+}
+
+/*
+The AddRecord method adds the record through the Primary factory; writes are never multiplexed.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSource: The data source code of the record.
+  - recordID: The identifier of the record within the data source.
+  - jsonData: The record as a JSON document.
+  - flags: The engine flags passed to AddRecordWithInfo, or FlagsWithoutInfo.
+*/
+func (factory *MultiplexSdkAbstractFactory) AddRecord(ctx context.Context, dataSource string, recordID string, jsonData string, flags Flags) (*AddRecordResult, error) {
+	return factory.Primary.AddRecord(ctx, dataSource, recordID, jsonData, flags)
+}
+
+/*
+The CheckCompatibility method checks the Primary and every Secondaries factory,
+since any of them may serve G2engine reads.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - All errors encountered, joined with errors.Join.
+*/
+func (factory *MultiplexSdkAbstractFactory) CheckCompatibility(ctx context.Context) error {
+	errs := []error{factory.Primary.CheckCompatibility(ctx)}
+	for _, secondary := range factory.Secondaries {
+		errs = append(errs, secondary.CheckCompatibility(ctx))
+	}
+	return errors.Join(errs...)
+}
+
+/*
+The CreatedObjects method returns the objects already created through the Primary factory.
+If the multiplexed G2engine has been created, it replaces Primary's G2engine in the result.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - A slice of the already-created G2* objects.
+*/
+func (factory *MultiplexSdkAbstractFactory) CreatedObjects(ctx context.Context) []interface{} {
+	factory.g2engineMutex.RLock()
+	defer factory.g2engineMutex.RUnlock()
+	result := factory.Primary.CreatedObjects(ctx)
+	if factory.g2engineSyncOnce.isDone() {
+		for index, object := range result {
+			if _, isG2engine := object.(g2api.G2engine); isG2engine {
+				result[index] = factory.g2engineSingleton
+			}
+		}
+	}
+	return result
+}
+
+/*
+The DebugInfo method returns the Primary factory's debug information.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The collected debug information.
+*/
+func (factory *MultiplexSdkAbstractFactory) DebugInfo(ctx context.Context) (DebugInfo, error) {
+	return factory.Primary.DebugInfo(ctx)
+}
+
+/*
+The Destroy method destroys the Primary and every Secondaries factory.
+Subsequent calls to GetG2engine build a new multiplexed G2engine;
+GetG2engine calls made during Destroy wait for it to finish.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - All errors encountered, joined with errors.Join.
+*/
+func (factory *MultiplexSdkAbstractFactory) Destroy(ctx context.Context) error {
+	factory.g2engineMutex.Lock()
+	defer factory.g2engineMutex.Unlock()
+	errs := []error{factory.Primary.Destroy(ctx)}
+	for _, secondary := range factory.Secondaries {
+		errs = append(errs, secondary.Destroy(ctx))
+	}
+	factory.resetG2engine()
+	return errors.Join(errs...)
+}
+
+/*
+The DestroyG2config method destroys the Primary factory's G2config.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (factory *MultiplexSdkAbstractFactory) DestroyG2config(ctx context.Context) error {
+	return factory.Primary.DestroyG2config(ctx)
+}
+
+/*
+The DestroyG2configmgr method destroys the Primary factory's G2configmgr.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (factory *MultiplexSdkAbstractFactory) DestroyG2configmgr(ctx context.Context) error {
+	return factory.Primary.DestroyG2configmgr(ctx)
+}
+
+/*
+The DestroyG2diagnostic method destroys the Primary factory's G2diagnostic.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (factory *MultiplexSdkAbstractFactory) DestroyG2diagnostic(ctx context.Context) error {
+	return factory.Primary.DestroyG2diagnostic(ctx)
+}
+
+/*
+The DestroyG2engine method destroys the G2engine of the Primary and every Secondaries factory.
+Subsequent calls to GetG2engine build a new multiplexed G2engine;
+GetG2engine calls made during DestroyG2engine wait for it to finish.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - All errors encountered, joined with errors.Join.
+*/
+func (factory *MultiplexSdkAbstractFactory) DestroyG2engine(ctx context.Context) error {
+	factory.g2engineMutex.Lock()
+	defer factory.g2engineMutex.Unlock()
+	errs := []error{factory.Primary.DestroyG2engine(ctx)}
+	for _, secondary := range factory.Secondaries {
+		errs = append(errs, secondary.DestroyG2engine(ctx))
+	}
+	factory.resetG2engine()
+	return errors.Join(errs...)
+}
+
+/*
+The DestroyG2product method destroys the Primary factory's G2product.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (factory *MultiplexSdkAbstractFactory) DestroyG2product(ctx context.Context) error {
+	return factory.Primary.DestroyG2product(ctx)
+}
+
+/*
+The DiagnosticStats method returns the Primary factory's diagnostic statistics.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The collected statistics.
+*/
+func (factory *MultiplexSdkAbstractFactory) DiagnosticStats(ctx context.Context) (DiagnosticStats, error) {
+	return factory.Primary.DiagnosticStats(ctx)
+}
+
+/*
+The EngineStats method returns the statistics of the Primary factory's G2engine.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (factory *MultiplexSdkAbstractFactory) EngineStats(ctx context.Context) (string, error) {
+	return factory.Primary.EngineStats(ctx)
+}
+
+/*
+The EngineStatsParsed method returns the parsed statistics of the Primary factory's G2engine.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (factory *MultiplexSdkAbstractFactory) EngineStatsParsed(ctx context.Context) (EngineStats, error) {
+	return factory.Primary.EngineStatsParsed(ctx)
+}
+
+/*
+The EnsureDefaultConfig method ensures the Primary factory's repository has a default configuration.
+
+Input
+  - ctx: A context to control lifecycle.
+  - configComments: The comments stored with a newly added configuration.
+*/
+func (factory *MultiplexSdkAbstractFactory) EnsureDefaultConfig(ctx context.Context, configComments string) error {
+	return factory.Primary.EnsureDefaultConfig(ctx, configComments)
+}
+
+/*
+The ExportEntities method streams the export from the Primary factory.
+Export cursors are backend-specific, so they are never multiplexed.
+
+Input
+  - ctx: A context to control lifecycle.
+  - flags: Flags passed to ExportJSONEntityReport to control the export.
+
+Output
+  - An io.ReadCloser yielding one JSON document per line.
+*/
+func (factory *MultiplexSdkAbstractFactory) ExportEntities(ctx context.Context, flags Flags) (io.ReadCloser, error) {
+	return factory.Primary.ExportEntities(ctx, flags)
+}
+
+/*
+The GetAll method returns the Senzing objects from the GetG2* methods, including the multiplexed G2engine.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The Senzing objects.
+  - The first error encountered, prefixed with the name of the object that failed.
+*/
+func (factory *MultiplexSdkAbstractFactory) GetAll(ctx context.Context) (*G2Objects, error) {
+	return getAll(ctx, factory)
+}
+
+/*
+The GetG2config method returns the Primary factory's G2config.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - A G2config object.
+*/
+func (factory *MultiplexSdkAbstractFactory) GetG2config(ctx context.Context) (g2api.G2config, error) {
+	return factory.Primary.GetG2config(ctx)
+}
+
+/*
+The GetG2configForConfigID method returns the Primary factory's G2config with configID loaded.
+
+Input
+  - ctx: A context to control lifecycle.
+  - configID: The identifier of a configuration stored in the Senzing repository.
+
+Output
+  - The G2config object.
+  - A configuration handle for the loaded configuration.
+*/
+func (factory *MultiplexSdkAbstractFactory) GetG2configForConfigID(ctx context.Context, configID int64) (g2api.G2config, uintptr, error) {
+	return factory.Primary.GetG2configForConfigID(ctx, configID)
+}
+
+/*
+The GetG2configWithLoad method returns the Primary factory's G2config with configID loaded.
+
+Input
+  - ctx: A context to control lifecycle.
+  - configID: The identifier of a configuration stored in the Senzing repository.
+
+Output
+  - The G2config object.
+  - A configuration handle holding the loaded configuration.
+*/
+func (factory *MultiplexSdkAbstractFactory) GetG2configWithLoad(ctx context.Context, configID int64) (g2api.G2config, uintptr, error) {
+	return factory.Primary.GetG2configWithLoad(ctx, configID)
+}
+
+/*
+The GetG2configmgr method returns the Primary factory's G2configmgr.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - A G2configmgr object.
+*/
+func (factory *MultiplexSdkAbstractFactory) GetG2configmgr(ctx context.Context) (g2api.G2configmgr, error) {
+	return factory.Primary.GetG2configmgr(ctx)
+}
+
+/*
+The GetG2diagnostic method returns the Primary factory's G2diagnostic.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - A G2diagnostic object.
+*/
+func (factory *MultiplexSdkAbstractFactory) GetG2diagnostic(ctx context.Context) (g2api.G2diagnostic, error) {
+	return factory.Primary.GetG2diagnostic(ctx)
+}
+
+/*
+The GetG2engine method returns a G2engine that round-robins read calls across the
+Primary and Secondaries factories and sends every other call to Primary.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - A G2engine object.
+*/
+func (factory *MultiplexSdkAbstractFactory) GetG2engine(ctx context.Context) (g2api.G2engine, error) {
+	factory.g2engineMutex.RLock()
+	defer factory.g2engineMutex.RUnlock()
+	err := factory.g2engineSyncOnce.Do(func() error {
+		primary, err := factory.Primary.GetG2engine(ctx)
+		if err != nil {
+			return err
+		}
+		readers := []g2api.G2engine{primary}
+		for _, secondary := range factory.Secondaries {
+			reader, err := secondary.GetG2engine(ctx)
+			if err != nil {
+				return err
+			}
+			readers = append(readers, reader)
+		}
+		factory.g2engineSingleton = &multiplexG2engine{
+			G2engine: primary,
+			readers:  readers,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return factory.g2engineSingleton, nil
+}
+
+/*
+The GetG2engineWithConfigID method returns the Primary factory's G2engine for configID.
+Reads are not multiplexed, because the Secondaries may use other configurations.
+
+Input
+  - ctx: A context to control lifecycle.
+  - configID: The identifier of a configuration stored in the Senzing repository.
+
+Output
+  - A G2engine object.
+*/
+func (factory *MultiplexSdkAbstractFactory) GetG2engineWithConfigID(ctx context.Context, configID int64) (g2api.G2engine, error) {
+	return factory.Primary.GetG2engineWithConfigID(ctx, configID)
+}
+
+/*
+The GetG2product method returns the Primary factory's G2product.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - A G2product object.
+*/
+func (factory *MultiplexSdkAbstractFactory) GetG2product(ctx context.Context) (g2api.G2product, error) {
+	return factory.Primary.GetG2product(ctx)
+}
+
+/*
+The GrpcConnection method returns the gRPC connection of the Primary factory.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The connection, owned by the Primary factory.
+*/
+func (factory *MultiplexSdkAbstractFactory) GrpcConnection(ctx context.Context) (*grpc.ClientConn, error) {
+	return factory.Primary.GrpcConnection(ctx)
+}
+
+/*
+The HealthCheck method checks the Primary and every Secondaries factory.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - All errors encountered, joined with errors.Join.
+*/
+func (factory *MultiplexSdkAbstractFactory) HealthCheck(ctx context.Context) error {
+	errs := []error{factory.Primary.HealthCheck(ctx)}
+	for _, secondary := range factory.Secondaries {
+		errs = append(errs, secondary.HealthCheck(ctx))
+	}
+	return errors.Join(errs...)
+}
+
+/*
+The Initialize method initializes the Primary and every Secondaries factory,
+then builds the multiplexed G2engine.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The first error encountered.
+*/
+func (factory *MultiplexSdkAbstractFactory) Initialize(ctx context.Context) error {
+	if err := factory.Primary.Initialize(ctx); err != nil {
+		return err
+	}
+	for _, secondary := range factory.Secondaries {
+		if err := secondary.Initialize(ctx); err != nil {
+			return err
+		}
+	}
+	_, err := factory.GetG2engine(ctx)
+	return err
+}
+
+/*
+The IsGrpc method reports whether the Primary factory communicates over gRPC.
+
+Output
+  - True if the Primary factory's mode is ModeGrpc.
+*/
+func (factory *MultiplexSdkAbstractFactory) IsGrpc() bool {
+	return factory.Primary.IsGrpc()
+}
+
+/*
+The License method returns the Primary factory's cached license details.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The parsed license details, and an error wrapping ErrLicenseExpired if it has expired.
+*/
+func (factory *MultiplexSdkAbstractFactory) License(ctx context.Context) (*License, error) {
+	return factory.Primary.License(ctx)
+}
+
+/*
+The LicenseInfo method returns the Primary factory's license details.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The parsed license details.
+*/
+func (factory *MultiplexSdkAbstractFactory) LicenseInfo(ctx context.Context) (LicenseInfo, error) {
+	return factory.Primary.LicenseInfo(ctx)
+}
+
+/*
+The Mode method returns the Primary factory's mode.
+Secondaries may use a different mode.
+
+Output
+  - ModeGrpc, ModeLocal, or ModeNull.
+*/
+func (factory *MultiplexSdkAbstractFactory) Mode() FactoryMode {
+	return factory.Primary.Mode()
+}
+
+/*
+The OnClose method registers hook with the Primary factory, so it runs once per Destroy,
+before the Primary's objects and connection are released.
+
+Input
+  - hook: The function to call with the context passed to Destroy.
+*/
+func (factory *MultiplexSdkAbstractFactory) OnClose(hook func(ctx context.Context) error) {
+	factory.Primary.OnClose(hook)
+}
+
+/*
+The ProcessRedoRecords method drains the redo queue of the Primary factory.
+
+Input
+  - ctx: A context to control lifecycle.
+  - maxRecords: The most records to process; 0 or less processes until the queue is empty.
+  - withInfo: Whether to use ProcessWithInfo and collect its withInfo JSON.
+
+Output
+  - The number of records processed, and the withInfo JSON of each.
+*/
+func (factory *MultiplexSdkAbstractFactory) ProcessRedoRecords(ctx context.Context, maxRecords int, withInfo bool) (int, []string, error) {
+	return factory.Primary.ProcessRedoRecords(ctx, maxRecords, withInfo)
+}
+
+/*
+The RegisterObserver method registers observer with the Primary and every Secondaries factory,
+so events from every backend serving multiplexed reads are observed.
+
+Input
+  - ctx: A context to control lifecycle.
+  - observer: The observer to be notified of Senzing object events.
+
+Output
+  - All errors encountered, joined with errors.Join.
+*/
+func (factory *MultiplexSdkAbstractFactory) RegisterObserver(ctx context.Context, observer observer.Observer) error {
+	errs := []error{factory.Primary.RegisterObserver(ctx, observer)}
+	for _, secondary := range factory.Secondaries {
+		errs = append(errs, secondary.RegisterObserver(ctx, observer))
+	}
+	return errors.Join(errs...)
+}
+
+/*
+The Reset method resets the Primary and every Secondaries factory.
+Subsequent calls to GetG2engine build a new multiplexed G2engine;
+GetG2engine calls made during Reset wait for it to finish.
+
+Input
+  - ctx: A context to control lifecycle.
+  - destroy: Whether the backends destroy their existing objects first.
+
+Output
+  - All errors encountered, joined with errors.Join.
+*/
+func (factory *MultiplexSdkAbstractFactory) Reset(ctx context.Context, destroy bool) error {
+	factory.g2engineMutex.Lock()
+	defer factory.g2engineMutex.Unlock()
+	errs := []error{factory.Primary.Reset(ctx, destroy)}
+	for _, secondary := range factory.Secondaries {
+		errs = append(errs, secondary.Reset(ctx, destroy))
+	}
+	factory.resetG2engine()
+	return errors.Join(errs...)
+}
+
+/*
+The SetMode method switches the Primary and every Secondaries factory to mode.
+Subsequent calls to GetG2engine build a new multiplexed G2engine;
+GetG2engine calls made during SetMode wait for it to finish.
+
+Input
+  - ctx: A context to control lifecycle.
+  - mode: ModeLocal, ModeGrpc, or ModeNull.
+
+Output
+  - All errors encountered, joined with errors.Join.
+*/
+func (factory *MultiplexSdkAbstractFactory) SetMode(ctx context.Context, mode FactoryMode) error {
+	factory.g2engineMutex.Lock()
+	defer factory.g2engineMutex.Unlock()
+	errs := []error{factory.Primary.SetMode(ctx, mode)}
+	for _, secondary := range factory.Secondaries {
+		errs = append(errs, secondary.SetMode(ctx, mode))
+	}
+	factory.resetG2engine()
+	return errors.Join(errs...)
+}
+
+/*
+The UnregisterObserver method unregisters observer from the Primary and every Secondaries factory.
+
+Input
+  - ctx: A context to control lifecycle.
+  - observer: The observer to be removed.
+
+Output
+  - All errors encountered, joined with errors.Join.
+*/
+func (factory *MultiplexSdkAbstractFactory) UnregisterObserver(ctx context.Context, observer observer.Observer) error {
+	errs := []error{factory.Primary.UnregisterObserver(ctx, observer)}
+	for _, secondary := range factory.Secondaries {
+		errs = append(errs, secondary.UnregisterObserver(ctx, observer))
+	}
+	return errors.Join(errs...)
+}
+
+/*
+The Validate method validates the Primary and every Secondaries factory.
+
+Output
+  - All problems found, joined with errors.Join, or nil.
+*/
+func (factory *MultiplexSdkAbstractFactory) Validate() error {
+	errs := []error{factory.Primary.Validate()}
+	for _, secondary := range factory.Secondaries {
+		errs = append(errs, secondary.Validate())
+	}
+	return errors.Join(errs...)
+}
+
+/*
+The VerifyGrpcServices method verifies the services of the Primary and of every Secondaries
+factory in ModeGrpc, skipping the others.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The joined errors of the gRPC factories, or ErrUnsupportedMode if there are none.
+*/
+func (factory *MultiplexSdkAbstractFactory) VerifyGrpcServices(ctx context.Context) error {
+	errs := []error{}
+	verified := false
+	for _, senzingFactory := range append([]ManagedSdkAbstractFactory{factory.Primary}, factory.Secondaries...) {
+		if senzingFactory.Mode() == ModeGrpc {
+			verified = true
+			errs = append(errs, senzingFactory.VerifyGrpcServices(ctx))
+		}
+	}
+	if !verified {
+		return fmt.Errorf("%w: VerifyGrpcServices requires a gRPC factory", ErrUnsupportedMode)
+	}
+	return errors.Join(errs...)
+}
+
+/*
+The WaitUntilReady method waits for the Primary and then every Secondaries factory to be ready,
+all within the same timeout.
+
+Input
+  - ctx: A context to control lifecycle.
+  - timeout: How long to wait for all the factories; zero or less waits until ctx is done.
+
+Output
+  - The first error encountered, wrapping ErrNotReady if a factory is not ready in time.
+*/
+func (factory *MultiplexSdkAbstractFactory) WaitUntilReady(ctx context.Context, timeout time.Duration) error {
+	if timeout > 0 && ctx != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	for _, senzingFactory := range append([]ManagedSdkAbstractFactory{factory.Primary}, factory.Secondaries...) {
+		if err := senzingFactory.WaitUntilReady(ctx, timeout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
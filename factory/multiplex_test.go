@@ -0,0 +1,514 @@
+package factory
+
+import (
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/senzing/g2-sdk-go/g2api"
+	"github.com/senzing/go-observing/observer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// ----------------------------------------------------------------------------
+// Test doubles
+// ----------------------------------------------------------------------------
+
+type recordingG2engine struct {
+	entityG2engine
+	addRecordCount int
+}
+
+func (g2engine *recordingG2engine) AddRecord(ctx context.Context, dataSourceCode string, recordID string, jsonData string, loadID string) error {
+	g2engine.addRecordCount++
+	return nil
+}
+
+// callRecordingFactory records the name of each method called on it, then delegates to a null-backend factory.
+type callRecordingFactory struct {
+	*SdkAbstractFactoryImpl
+	calls          []string
+	getG2engineErr error
+	mutex          sync.Mutex
+}
+
+func (factory *callRecordingFactory) record(name string) {
+	factory.mutex.Lock()
+	defer factory.mutex.Unlock()
+	factory.calls = append(factory.calls, name)
+}
+
+func (factory *callRecordingFactory) ActiveConfigID(ctx context.Context) (int64, error) {
+	factory.record("ActiveConfigID")
+	return factory.SdkAbstractFactoryImpl.ActiveConfigID(ctx)
+}
+
+func (factory *callRecordingFactory) AddRecord(ctx context.Context, dataSource string, recordID string, jsonData string, flags Flags) (*AddRecordResult, error) {
+	factory.record("AddRecord")
+	return factory.SdkAbstractFactoryImpl.AddRecord(ctx, dataSource, recordID, jsonData, flags)
+}
+
+func (factory *callRecordingFactory) CheckCompatibility(ctx context.Context) error {
+	factory.record("CheckCompatibility")
+	return factory.SdkAbstractFactoryImpl.CheckCompatibility(ctx)
+}
+
+func (factory *callRecordingFactory) CreatedObjects(ctx context.Context) []interface{} {
+	factory.record("CreatedObjects")
+	return factory.SdkAbstractFactoryImpl.CreatedObjects(ctx)
+}
+
+func (factory *callRecordingFactory) DebugInfo(ctx context.Context) (DebugInfo, error) {
+	factory.record("DebugInfo")
+	return factory.SdkAbstractFactoryImpl.DebugInfo(ctx)
+}
+
+func (factory *callRecordingFactory) Destroy(ctx context.Context) error {
+	factory.record("Destroy")
+	return factory.SdkAbstractFactoryImpl.Destroy(ctx)
+}
+
+func (factory *callRecordingFactory) DestroyG2config(ctx context.Context) error {
+	factory.record("DestroyG2config")
+	return factory.SdkAbstractFactoryImpl.DestroyG2config(ctx)
+}
+
+func (factory *callRecordingFactory) DestroyG2configmgr(ctx context.Context) error {
+	factory.record("DestroyG2configmgr")
+	return factory.SdkAbstractFactoryImpl.DestroyG2configmgr(ctx)
+}
+
+func (factory *callRecordingFactory) DestroyG2diagnostic(ctx context.Context) error {
+	factory.record("DestroyG2diagnostic")
+	return factory.SdkAbstractFactoryImpl.DestroyG2diagnostic(ctx)
+}
+
+func (factory *callRecordingFactory) DestroyG2engine(ctx context.Context) error {
+	factory.record("DestroyG2engine")
+	return factory.SdkAbstractFactoryImpl.DestroyG2engine(ctx)
+}
+
+func (factory *callRecordingFactory) DestroyG2product(ctx context.Context) error {
+	factory.record("DestroyG2product")
+	return factory.SdkAbstractFactoryImpl.DestroyG2product(ctx)
+}
+
+func (factory *callRecordingFactory) DiagnosticStats(ctx context.Context) (DiagnosticStats, error) {
+	factory.record("DiagnosticStats")
+	return factory.SdkAbstractFactoryImpl.DiagnosticStats(ctx)
+}
+
+func (factory *callRecordingFactory) EngineStats(ctx context.Context) (string, error) {
+	factory.record("EngineStats")
+	return factory.SdkAbstractFactoryImpl.EngineStats(ctx)
+}
+
+func (factory *callRecordingFactory) EngineStatsParsed(ctx context.Context) (EngineStats, error) {
+	factory.record("EngineStatsParsed")
+	return factory.SdkAbstractFactoryImpl.EngineStatsParsed(ctx)
+}
+
+func (factory *callRecordingFactory) EnsureDefaultConfig(ctx context.Context, configComments string) error {
+	factory.record("EnsureDefaultConfig")
+	return factory.SdkAbstractFactoryImpl.EnsureDefaultConfig(ctx, configComments)
+}
+
+func (factory *callRecordingFactory) ExportEntities(ctx context.Context, flags Flags) (io.ReadCloser, error) {
+	factory.record("ExportEntities")
+	return factory.SdkAbstractFactoryImpl.ExportEntities(ctx, flags)
+}
+
+func (factory *callRecordingFactory) GetG2config(ctx context.Context) (g2api.G2config, error) {
+	factory.record("GetG2config")
+	return factory.SdkAbstractFactoryImpl.GetG2config(ctx)
+}
+
+func (factory *callRecordingFactory) GetG2configForConfigID(ctx context.Context, configID int64) (g2api.G2config, uintptr, error) {
+	factory.record("GetG2configForConfigID")
+	return factory.SdkAbstractFactoryImpl.GetG2configForConfigID(ctx, configID)
+}
+
+func (factory *callRecordingFactory) GetG2configWithLoad(ctx context.Context, configID int64) (g2api.G2config, uintptr, error) {
+	factory.record("GetG2configWithLoad")
+	return factory.SdkAbstractFactoryImpl.GetG2configWithLoad(ctx, configID)
+}
+
+func (factory *callRecordingFactory) GetG2configmgr(ctx context.Context) (g2api.G2configmgr, error) {
+	factory.record("GetG2configmgr")
+	return factory.SdkAbstractFactoryImpl.GetG2configmgr(ctx)
+}
+
+func (factory *callRecordingFactory) GetG2diagnostic(ctx context.Context) (g2api.G2diagnostic, error) {
+	factory.record("GetG2diagnostic")
+	return factory.SdkAbstractFactoryImpl.GetG2diagnostic(ctx)
+}
+
+func (factory *callRecordingFactory) GetG2engine(ctx context.Context) (g2api.G2engine, error) {
+	factory.record("GetG2engine")
+	if factory.getG2engineErr != nil {
+		return nil, factory.getG2engineErr
+	}
+	return factory.SdkAbstractFactoryImpl.GetG2engine(ctx)
+}
+
+func (factory *callRecordingFactory) GetG2engineWithConfigID(ctx context.Context, configID int64) (g2api.G2engine, error) {
+	factory.record("GetG2engineWithConfigID")
+	return factory.SdkAbstractFactoryImpl.GetG2engineWithConfigID(ctx, configID)
+}
+
+func (factory *callRecordingFactory) GetG2product(ctx context.Context) (g2api.G2product, error) {
+	factory.record("GetG2product")
+	return factory.SdkAbstractFactoryImpl.GetG2product(ctx)
+}
+
+func (factory *callRecordingFactory) GrpcConnection(ctx context.Context) (*grpc.ClientConn, error) {
+	factory.record("GrpcConnection")
+	return factory.SdkAbstractFactoryImpl.GrpcConnection(ctx)
+}
+
+func (factory *callRecordingFactory) HealthCheck(ctx context.Context) error {
+	factory.record("HealthCheck")
+	return factory.SdkAbstractFactoryImpl.HealthCheck(ctx)
+}
+
+func (factory *callRecordingFactory) Initialize(ctx context.Context) error {
+	factory.record("Initialize")
+	return factory.SdkAbstractFactoryImpl.Initialize(ctx)
+}
+
+func (factory *callRecordingFactory) OnClose(hook func(ctx context.Context) error) {
+	factory.record("OnClose")
+	factory.SdkAbstractFactoryImpl.OnClose(hook)
+}
+
+func (factory *callRecordingFactory) IsGrpc() bool {
+	factory.record("IsGrpc")
+	return factory.SdkAbstractFactoryImpl.IsGrpc()
+}
+
+func (factory *callRecordingFactory) License(ctx context.Context) (*License, error) {
+	factory.record("License")
+	return factory.SdkAbstractFactoryImpl.License(ctx)
+}
+
+func (factory *callRecordingFactory) LicenseInfo(ctx context.Context) (LicenseInfo, error) {
+	factory.record("LicenseInfo")
+	return factory.SdkAbstractFactoryImpl.LicenseInfo(ctx)
+}
+
+func (factory *callRecordingFactory) Mode() FactoryMode {
+	factory.record("Mode")
+	return factory.SdkAbstractFactoryImpl.Mode()
+}
+
+func (factory *callRecordingFactory) ProcessRedoRecords(ctx context.Context, maxRecords int, withInfo bool) (int, []string, error) {
+	factory.record("ProcessRedoRecords")
+	return factory.SdkAbstractFactoryImpl.ProcessRedoRecords(ctx, maxRecords, withInfo)
+}
+
+func (factory *callRecordingFactory) RegisterObserver(ctx context.Context, observer observer.Observer) error {
+	factory.record("RegisterObserver")
+	return factory.SdkAbstractFactoryImpl.RegisterObserver(ctx, observer)
+}
+
+func (factory *callRecordingFactory) Reset(ctx context.Context, destroy bool) error {
+	factory.record("Reset")
+	return factory.SdkAbstractFactoryImpl.Reset(ctx, destroy)
+}
+
+func (factory *callRecordingFactory) SetMode(ctx context.Context, mode FactoryMode) error {
+	factory.record("SetMode")
+	return factory.SdkAbstractFactoryImpl.SetMode(ctx, mode)
+}
+
+func (factory *callRecordingFactory) UnregisterObserver(ctx context.Context, observer observer.Observer) error {
+	factory.record("UnregisterObserver")
+	return factory.SdkAbstractFactoryImpl.UnregisterObserver(ctx, observer)
+}
+
+func (factory *callRecordingFactory) Validate() error {
+	factory.record("Validate")
+	return factory.SdkAbstractFactoryImpl.Validate()
+}
+
+func (factory *callRecordingFactory) VerifyGrpcServices(ctx context.Context) error {
+	factory.record("VerifyGrpcServices")
+	return factory.SdkAbstractFactoryImpl.VerifyGrpcServices(ctx)
+}
+
+func (factory *callRecordingFactory) WaitUntilReady(ctx context.Context, timeout time.Duration) error {
+	factory.record("WaitUntilReady")
+	return factory.SdkAbstractFactoryImpl.WaitUntilReady(ctx, timeout)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func newCallRecordingFactory(test *testing.T) *callRecordingFactory {
+	nullFactory, err := New(WithNullBackend())
+	require.NoError(test, err)
+	return &callRecordingFactory{SdkAbstractFactoryImpl: nullFactory.(*SdkAbstractFactoryImpl)}
+}
+
+// Return a multiplexed factory over one Primary and two Secondaries that record their calls.
+func getTestObjectMultiplex(test *testing.T) (*MultiplexSdkAbstractFactory, *callRecordingFactory, []*callRecordingFactory) {
+	primary := newCallRecordingFactory(test)
+	secondaries := []*callRecordingFactory{newCallRecordingFactory(test), newCallRecordingFactory(test)}
+	testObject := &MultiplexSdkAbstractFactory{
+		Primary:     primary,
+		Secondaries: []ManagedSdkAbstractFactory{secondaries[0], secondaries[1]},
+	}
+	return testObject, primary, secondaries
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestMultiplexSdkAbstractFactory_GetG2engine(test *testing.T) {
+	ctx := context.TODO()
+	primary := &recordingG2engine{entityG2engine: entityG2engine{entity: "primary"}}
+	secondary := &recordingG2engine{entityG2engine: entityG2engine{entity: "secondary"}}
+	testObject := &MultiplexSdkAbstractFactory{
+		Primary:     getTestObjectWithG2engine(primary),
+		Secondaries: []ManagedSdkAbstractFactory{getTestObjectWithG2engine(secondary)},
+	}
+	g2engine, err := testObject.GetG2engine(ctx)
+	testError(test, ctx, err)
+
+	// Reads alternate between backends.
+
+	actual := []string{}
+	for i := 0; i < 4; i++ {
+		entity, err := g2engine.GetEntityByEntityID(ctx, 1)
+		testError(test, ctx, err)
+		actual = append(actual, entity)
+	}
+	assert.Equal(test, []string{"primary", "secondary", "primary", "secondary"}, actual)
+
+	// Writes are pinned to the primary.
+
+	for i := 0; i < 3; i++ {
+		err = g2engine.AddRecord(ctx, "TEST", "1", "{}", "TEST")
+		testError(test, ctx, err)
+	}
+	assert.Equal(test, 3, primary.addRecordCount)
+	assert.Equal(test, 0, secondary.addRecordCount)
+}
+
+func TestMultiplexSdkAbstractFactory_GetG2engine_readMethods(test *testing.T) {
+	readMethods := map[string]bool{
+		"CheckRecord":                          true,
+		"FindInterestingEntitiesByEntityID":    true,
+		"FindInterestingEntitiesByRecordID":    true,
+		"FindNetworkByEntityID":                true,
+		"FindNetworkByEntityID_V2":             true,
+		"FindNetworkByRecordID":                true,
+		"FindNetworkByRecordID_V2":             true,
+		"FindPathByEntityID":                   true,
+		"FindPathByEntityID_V2":                true,
+		"FindPathByRecordID":                   true,
+		"FindPathByRecordID_V2":                true,
+		"FindPathExcludingByEntityID":          true,
+		"FindPathExcludingByEntityID_V2":       true,
+		"FindPathExcludingByRecordID":          true,
+		"FindPathExcludingByRecordID_V2":       true,
+		"FindPathIncludingSourceByEntityID":    true,
+		"FindPathIncludingSourceByEntityID_V2": true,
+		"FindPathIncludingSourceByRecordID":    true,
+		"FindPathIncludingSourceByRecordID_V2": true,
+		"GetEntityByEntityID":                  true,
+		"GetEntityByEntityID_V2":               true,
+		"GetEntityByRecordID":                  true,
+		"GetEntityByRecordID_V2":               true,
+		"GetRecord":                            true,
+		"GetRecord_V2":                         true,
+		"GetVirtualEntityByRecordID":           true,
+		"GetVirtualEntityByRecordID_V2":        true,
+		"HowEntityByEntityID":                  true,
+		"HowEntityByEntityID_V2":               true,
+		"SearchByAttributes":                   true,
+		"SearchByAttributes_V2":                true,
+		"WhyEntities":                          true,
+		"WhyEntities_V2":                       true,
+		"WhyEntityByEntityID":                  true,
+		"WhyEntityByEntityID_V2":               true,
+		"WhyEntityByRecordID":                  true,
+		"WhyEntityByRecordID_V2":               true,
+		"WhyRecords":                           true,
+		"WhyRecords_V2":                        true,
+	}
+
+	// Every G2engine method is called with zero arguments on backends that implement nothing;
+	// a read method advances the rotation before its call fails, any other method does not.
+	engineType := reflect.TypeOf((*g2api.G2engine)(nil)).Elem()
+	for i := 0; i < engineType.NumMethod(); i++ {
+		method := engineType.Method(i)
+		g2engine := &multiplexG2engine{
+			G2engine: &nullG2engine{},
+			readers:  []g2api.G2engine{&nullG2engine{}, &nullG2engine{}},
+		}
+		args := []reflect.Value{reflect.ValueOf(context.TODO())}
+		for j := 1; j < method.Type.NumIn(); j++ {
+			args = append(args, reflect.Zero(method.Type.In(j)))
+		}
+		func() {
+			defer func() { recover() }()
+			reflect.ValueOf(g2engine).MethodByName(method.Name).Call(args)
+		}()
+		if readMethods[method.Name] {
+			assert.Equal(test, uint64(1), g2engine.next, "%s must round-robin across the backends", method.Name)
+		} else {
+			assert.Zero(test, g2engine.next, "%s must go to the Primary", method.Name)
+		}
+	}
+}
+
+func TestMultiplexSdkAbstractFactory_delegation(test *testing.T) {
+	ctx := context.TODO()
+	testObserver := &recordingObserver{}
+	testCases := []struct {
+		method string
+		call   func(factory *MultiplexSdkAbstractFactory)
+		fanOut bool
+	}{
+		{"ActiveConfigID", func(factory *MultiplexSdkAbstractFactory) { factory.ActiveConfigID(ctx) }, false},
+		{"AddRecord", func(factory *MultiplexSdkAbstractFactory) { factory.AddRecord(ctx, "TEST", "1", "{}", FlagsNone) }, false},
+		{"CheckCompatibility", func(factory *MultiplexSdkAbstractFactory) { factory.CheckCompatibility(ctx) }, true},
+		{"CreatedObjects", func(factory *MultiplexSdkAbstractFactory) { factory.CreatedObjects(ctx) }, false},
+		{"DebugInfo", func(factory *MultiplexSdkAbstractFactory) { factory.DebugInfo(ctx) }, false},
+		{"Destroy", func(factory *MultiplexSdkAbstractFactory) { factory.Destroy(ctx) }, true},
+		{"DestroyG2config", func(factory *MultiplexSdkAbstractFactory) { factory.DestroyG2config(ctx) }, false},
+		{"DestroyG2configmgr", func(factory *MultiplexSdkAbstractFactory) { factory.DestroyG2configmgr(ctx) }, false},
+		{"DestroyG2diagnostic", func(factory *MultiplexSdkAbstractFactory) { factory.DestroyG2diagnostic(ctx) }, false},
+		{"DestroyG2engine", func(factory *MultiplexSdkAbstractFactory) { factory.DestroyG2engine(ctx) }, true},
+		{"DestroyG2product", func(factory *MultiplexSdkAbstractFactory) { factory.DestroyG2product(ctx) }, false},
+		{"DiagnosticStats", func(factory *MultiplexSdkAbstractFactory) { factory.DiagnosticStats(ctx) }, false},
+		{"EngineStats", func(factory *MultiplexSdkAbstractFactory) { factory.EngineStats(ctx) }, false},
+		{"EngineStatsParsed", func(factory *MultiplexSdkAbstractFactory) { factory.EngineStatsParsed(ctx) }, false},
+		{"EnsureDefaultConfig", func(factory *MultiplexSdkAbstractFactory) { factory.EnsureDefaultConfig(ctx, "comments") }, false},
+		{"ExportEntities", func(factory *MultiplexSdkAbstractFactory) {
+			if reader, err := factory.ExportEntities(ctx, FlagsNone); err == nil {
+				reader.Close()
+			}
+		}, false},
+		{"GetG2config", func(factory *MultiplexSdkAbstractFactory) { factory.GetG2config(ctx) }, false},
+		{"GetG2configForConfigID", func(factory *MultiplexSdkAbstractFactory) { factory.GetG2configForConfigID(ctx, 1) }, false},
+		{"GetG2configWithLoad", func(factory *MultiplexSdkAbstractFactory) { factory.GetG2configWithLoad(ctx, 1) }, false},
+		{"GetG2configmgr", func(factory *MultiplexSdkAbstractFactory) { factory.GetG2configmgr(ctx) }, false},
+		{"GetG2diagnostic", func(factory *MultiplexSdkAbstractFactory) { factory.GetG2diagnostic(ctx) }, false},
+		{"GetG2engine", func(factory *MultiplexSdkAbstractFactory) { factory.GetG2engine(ctx) }, true},
+		{"GetG2engineWithConfigID", func(factory *MultiplexSdkAbstractFactory) { factory.GetG2engineWithConfigID(ctx, 1) }, false},
+		{"GetG2product", func(factory *MultiplexSdkAbstractFactory) { factory.GetG2product(ctx) }, false},
+		{"GrpcConnection", func(factory *MultiplexSdkAbstractFactory) { factory.GrpcConnection(ctx) }, false},
+		{"HealthCheck", func(factory *MultiplexSdkAbstractFactory) { factory.HealthCheck(ctx) }, true},
+		{"Initialize", func(factory *MultiplexSdkAbstractFactory) { factory.Initialize(ctx) }, true},
+		{"OnClose", func(factory *MultiplexSdkAbstractFactory) { factory.OnClose(nil) }, false},
+		{"IsGrpc", func(factory *MultiplexSdkAbstractFactory) { factory.IsGrpc() }, false},
+		{"License", func(factory *MultiplexSdkAbstractFactory) { factory.License(ctx) }, false},
+		{"LicenseInfo", func(factory *MultiplexSdkAbstractFactory) { factory.LicenseInfo(ctx) }, false},
+		{"Mode", func(factory *MultiplexSdkAbstractFactory) { factory.Mode() }, false},
+		{"ProcessRedoRecords", func(factory *MultiplexSdkAbstractFactory) { factory.ProcessRedoRecords(ctx, 1, false) }, false},
+		{"RegisterObserver", func(factory *MultiplexSdkAbstractFactory) { factory.RegisterObserver(ctx, testObserver) }, true},
+		{"Reset", func(factory *MultiplexSdkAbstractFactory) { factory.Reset(ctx, false) }, true},
+		{"SetMode", func(factory *MultiplexSdkAbstractFactory) { factory.SetMode(ctx, ModeNull) }, true},
+		{"UnregisterObserver", func(factory *MultiplexSdkAbstractFactory) { factory.UnregisterObserver(ctx, testObserver) }, true},
+		{"Validate", func(factory *MultiplexSdkAbstractFactory) { factory.Validate() }, true},
+		{"WaitUntilReady", func(factory *MultiplexSdkAbstractFactory) { factory.WaitUntilReady(ctx, time.Second) }, true},
+	}
+	for _, testCase := range testCases {
+		test.Run(testCase.method, func(test *testing.T) {
+			testObject, primary, secondaries := getTestObjectMultiplex(test)
+			testCase.call(testObject)
+			assert.Contains(test, primary.calls, testCase.method)
+			for _, secondary := range secondaries {
+				if testCase.fanOut {
+					assert.Contains(test, secondary.calls, testCase.method)
+				} else {
+					assert.NotContains(test, secondary.calls, testCase.method)
+				}
+			}
+		})
+	}
+}
+
+func TestMultiplexSdkAbstractFactory_VerifyGrpcServices_noGrpcBackend(test *testing.T) {
+	ctx := context.TODO()
+	testObject, primary, secondaries := getTestObjectMultiplex(test)
+	err := testObject.VerifyGrpcServices(ctx)
+	assert.ErrorIs(test, err, ErrUnsupportedMode)
+	assert.NotContains(test, primary.calls, "VerifyGrpcServices")
+	assert.NotContains(test, secondaries[0].calls, "VerifyGrpcServices")
+}
+
+func TestMultiplexSdkAbstractFactory_GetG2engine_secondaryFails(test *testing.T) {
+	ctx := context.TODO()
+	testObject, _, secondaries := getTestObjectMultiplex(test)
+	errUnavailable := errors.New("secondary unavailable")
+	secondaries[1].getG2engineErr = errUnavailable
+	_, err := testObject.GetG2engine(ctx)
+	assert.ErrorIs(test, err, errUnavailable)
+
+	// The multiplexed G2engine is built once every backend is available again.
+	secondaries[1].getG2engineErr = nil
+	g2engine, err := testObject.GetG2engine(ctx)
+	testError(test, ctx, err)
+	assert.Len(test, g2engine.(*multiplexG2engine).readers, 3)
+}
+
+func TestMultiplexSdkAbstractFactory_rebuildsG2engine(test *testing.T) {
+	ctx := context.TODO()
+	for method, reset := range map[string]func(factory *MultiplexSdkAbstractFactory) error{
+		"Destroy":         func(factory *MultiplexSdkAbstractFactory) error { return factory.Destroy(ctx) },
+		"DestroyG2engine": func(factory *MultiplexSdkAbstractFactory) error { return factory.DestroyG2engine(ctx) },
+		"Reset":           func(factory *MultiplexSdkAbstractFactory) error { return factory.Reset(ctx, true) },
+		"SetMode":         func(factory *MultiplexSdkAbstractFactory) error { return factory.SetMode(ctx, ModeNull) },
+	} {
+		test.Run(method, func(test *testing.T) {
+			testObject, _, _ := getTestObjectMultiplex(test)
+			before, err := testObject.GetG2engine(ctx)
+			testError(test, ctx, err)
+			testError(test, ctx, reset(testObject))
+			after, err := testObject.GetG2engine(ctx)
+			testError(test, ctx, err)
+			assert.NotSame(test, before, after)
+			if method != "SetMode" {
+				// SetMode to the current mode leaves the backends' objects alone.
+				assert.NotSame(test, before.(*multiplexG2engine).G2engine, after.(*multiplexG2engine).G2engine)
+			}
+		})
+	}
+}
+
+func TestMultiplexSdkAbstractFactory_Reset_concurrentGetG2engine(test *testing.T) {
+	ctx := context.TODO()
+	testObject, _, _ := getTestObjectMultiplex(test)
+	var waitGroup sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		waitGroup.Add(2)
+		go func() {
+			defer waitGroup.Done()
+			for j := 0; j < 25; j++ {
+				g2engine, err := testObject.GetG2engine(ctx)
+				if assert.NoError(test, err) {
+					assert.NotNil(test, g2engine)
+				}
+			}
+		}()
+		go func() {
+			defer waitGroup.Done()
+			for j := 0; j < 25; j++ {
+				assert.NoError(test, testObject.Reset(ctx, true))
+				testObject.CreatedObjects(ctx)
+			}
+		}()
+	}
+	waitGroup.Wait()
+}
@@ -0,0 +1,63 @@
+package factory
+
+import (
+	"strings"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// localInitParameters are the arguments passed to the Init methods of the local Senzing objects.
+type localInitParameters struct {
+	engineConfigurationJson string
+	environment             string
+	moduleName              string
+	moduleNameTemplate      string
+	verboseLogging          int
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Placeholder in ModuleName and ModuleNameTemplate for the factory's Environment.
+const moduleNameEnvironmentPlaceholder = "{environment}"
+
+// Placeholder in ModuleNameTemplate for the name of the object being initialized, e.g. "g2engine".
+const moduleNameObjectPlaceholder = "{object}"
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Return the module name for the named object: ModuleNameTemplate expanded for it, or ModuleName,
+// with the environment in place of its placeholder.
+func (parameters localInitParameters) moduleNameFor(objectName string) string {
+	result := parameters.moduleName
+	if len(parameters.moduleNameTemplate) > 0 {
+		result = strings.ReplaceAll(parameters.moduleNameTemplate, moduleNameObjectPlaceholder, objectName)
+	}
+	return strings.ReplaceAll(result, moduleNameEnvironmentPlaceholder, parameters.environment)
+}
+
+// Return the Init arguments for the next local Senzing object.  With SharedNativeInit, these are
+// the arguments of the first local object created since the last Destroy or Reset, so that every
+// object initializes the process-wide native library the same way even if the fields change.
+func (factory *SdkAbstractFactoryImpl) getLocalInitParameters() localInitParameters {
+	current := localInitParameters{
+		engineConfigurationJson: factory.EngineConfigurationJson,
+		environment:             factory.Environment,
+		moduleName:              factory.ModuleName,
+		moduleNameTemplate:      factory.ModuleNameTemplate,
+		verboseLogging:          factory.VerboseLogging,
+	}
+	if !factory.SharedNativeInit {
+		return current
+	}
+	factory.nativeInitSyncOnce.Do(func() error {
+		factory.nativeInitParameters = current
+		return nil
+	})
+	return factory.nativeInitParameters
+}
@@ -0,0 +1,67 @@
+package factory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSdkAbstractFactoryImpl_getLocalInitParameters(test *testing.T) {
+	testObject := &SdkAbstractFactoryImpl{ModuleName: "first", EngineConfigurationJson: "{}", VerboseLogging: 1}
+	assert.Equal(test, "first", testObject.getLocalInitParameters().moduleName)
+	testObject.ModuleName = "second"
+	assert.Equal(test, "second", testObject.getLocalInitParameters().moduleName, "without SharedNativeInit the current fields are used")
+}
+
+func TestSdkAbstractFactoryImpl_getLocalInitParameters_shared(test *testing.T) {
+	ctx := context.TODO()
+	testObject := &SdkAbstractFactoryImpl{ModuleName: "first", EngineConfigurationJson: "{}", VerboseLogging: 1}
+	err := WithSharedNativeInit()(testObject)
+	testError(test, ctx, err)
+	expected := localInitParameters{engineConfigurationJson: "{}", moduleName: "first", verboseLogging: 1}
+
+	// One call per local object: G2config, G2configmgr, G2diagnostic, G2engine, and G2product.
+	moduleNames := []string{}
+	for _, moduleName := range []string{"first", "second", "third", "fourth", "fifth"} {
+		testObject.ModuleName = moduleName
+		actual := testObject.getLocalInitParameters()
+		assert.Equal(test, expected, actual)
+		moduleNames = append(moduleNames, actual.moduleName)
+		testObject.VerboseLogging++
+	}
+	assert.Equal(test, []string{"first", "first", "first", "first", "first"}, moduleNames)
+
+	testError(test, ctx, testObject.Reset(ctx, false))
+	assert.Equal(test, "fifth", testObject.getLocalInitParameters().moduleName, "Reset must discard the shared parameters")
+}
+
+func TestSdkAbstractFactoryImpl_Clone_sharedNativeInit(test *testing.T) {
+	ctx := context.TODO()
+	testObject := &SdkAbstractFactoryImpl{ModuleName: "original", SharedNativeInit: true}
+	assert.Equal(test, "original", testObject.getLocalInitParameters().moduleName)
+	result, err := testObject.Clone(WithModuleName("clone"), WithNullBackend())
+	testError(test, ctx, err)
+	clone := result.(*SdkAbstractFactoryImpl)
+	assert.True(test, clone.SharedNativeInit)
+	assert.Equal(test, "clone", clone.getLocalInitParameters().moduleName, "the clone must not inherit the original's parameters")
+}
+
+func TestSdkAbstractFactoryImpl_getLocalInitParameters_moduleNameTemplate(test *testing.T) {
+	ctx := context.TODO()
+	testObject := &SdkAbstractFactoryImpl{ModuleName: "plain"}
+	assert.Equal(test, "plain", testObject.getLocalInitParameters().moduleNameFor("g2engine"))
+	err := WithModuleNameTemplate("my-app-{object}")(testObject)
+	testError(test, ctx, err)
+	initParameters := testObject.getLocalInitParameters()
+	assert.Equal(test, "my-app-g2engine", initParameters.moduleNameFor("g2engine"))
+	assert.Equal(test, "my-app-g2diagnostic", initParameters.moduleNameFor("g2diagnostic"))
+}
+
+func TestWithModuleNameTemplate_invalid(test *testing.T) {
+	assert.Error(test, WithModuleNameTemplate("my-app")(&SdkAbstractFactoryImpl{}))
+}
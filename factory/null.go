@@ -0,0 +1,313 @@
+package factory
+
+import (
+	"context"
+
+	"github.com/senzing/g2-sdk-go/g2api"
+	"github.com/senzing/go-observing/observer"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// The null backend's objects, returned in ModeNull, let dry runs exercise code that obtains
+// and calls Senzing objects with no Senzing runtime present.  Their methods do nothing and
+// return zero values and nil errors.  Each embeds a nil g2api interface so it satisfies it;
+// methods beyond those implemented below panic.
+
+type nullG2config struct {
+	g2api.G2config
+}
+
+type nullG2configmgr struct {
+	g2api.G2configmgr
+}
+
+type nullG2diagnostic struct {
+	g2api.G2diagnostic
+}
+
+type nullG2engine struct {
+	g2api.G2engine
+}
+
+type nullG2product struct {
+	g2api.G2product
+}
+
+// ----------------------------------------------------------------------------
+// nullG2config
+// ----------------------------------------------------------------------------
+
+func (g2config *nullG2config) AddDataSource(ctx context.Context, configHandle uintptr, inputJson string) (string, error) {
+	return "", nil
+}
+
+func (g2config *nullG2config) Close(ctx context.Context, configHandle uintptr) error {
+	return nil
+}
+
+func (g2config *nullG2config) Create(ctx context.Context) (uintptr, error) {
+	return 0, nil
+}
+
+func (g2config *nullG2config) DeleteDataSource(ctx context.Context, configHandle uintptr, inputJson string) error {
+	return nil
+}
+
+func (g2config *nullG2config) Destroy(ctx context.Context) error {
+	return nil
+}
+
+func (g2config *nullG2config) GetSdkId(ctx context.Context) string {
+	return ""
+}
+
+func (g2config *nullG2config) Init(ctx context.Context, moduleName string, iniParams string, verboseLogging int) error {
+	return nil
+}
+
+func (g2config *nullG2config) ListDataSources(ctx context.Context, configHandle uintptr) (string, error) {
+	return "", nil
+}
+
+func (g2config *nullG2config) Load(ctx context.Context, configHandle uintptr, jsonConfig string) error {
+	return nil
+}
+
+func (g2config *nullG2config) RegisterObserver(ctx context.Context, observer observer.Observer) error {
+	return nil
+}
+
+func (g2config *nullG2config) Save(ctx context.Context, configHandle uintptr) (string, error) {
+	return "", nil
+}
+
+func (g2config *nullG2config) UnregisterObserver(ctx context.Context, observer observer.Observer) error {
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// nullG2configmgr
+// ----------------------------------------------------------------------------
+
+func (g2configmgr *nullG2configmgr) AddConfig(ctx context.Context, configStr string, configComments string) (int64, error) {
+	return 0, nil
+}
+
+func (g2configmgr *nullG2configmgr) Destroy(ctx context.Context) error {
+	return nil
+}
+
+func (g2configmgr *nullG2configmgr) GetConfig(ctx context.Context, configID int64) (string, error) {
+	return "", nil
+}
+
+func (g2configmgr *nullG2configmgr) GetConfigList(ctx context.Context) (string, error) {
+	return "", nil
+}
+
+func (g2configmgr *nullG2configmgr) GetDefaultConfigID(ctx context.Context) (int64, error) {
+	return 0, nil
+}
+
+func (g2configmgr *nullG2configmgr) GetSdkId(ctx context.Context) string {
+	return ""
+}
+
+func (g2configmgr *nullG2configmgr) Init(ctx context.Context, moduleName string, iniParams string, verboseLogging int) error {
+	return nil
+}
+
+func (g2configmgr *nullG2configmgr) RegisterObserver(ctx context.Context, observer observer.Observer) error {
+	return nil
+}
+
+func (g2configmgr *nullG2configmgr) ReplaceDefaultConfigID(ctx context.Context, oldConfigID int64, newConfigID int64) error {
+	return nil
+}
+
+func (g2configmgr *nullG2configmgr) SetDefaultConfigID(ctx context.Context, configID int64) error {
+	return nil
+}
+
+func (g2configmgr *nullG2configmgr) UnregisterObserver(ctx context.Context, observer observer.Observer) error {
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// nullG2diagnostic
+// ----------------------------------------------------------------------------
+
+func (g2diagnostic *nullG2diagnostic) CheckDBPerf(ctx context.Context, secondsToRun int) (string, error) {
+	return "", nil
+}
+
+func (g2diagnostic *nullG2diagnostic) Destroy(ctx context.Context) error {
+	return nil
+}
+
+func (g2diagnostic *nullG2diagnostic) GetAvailableMemory(ctx context.Context) (int64, error) {
+	return 0, nil
+}
+
+func (g2diagnostic *nullG2diagnostic) GetLogicalCores(ctx context.Context) (int, error) {
+	return 0, nil
+}
+
+func (g2diagnostic *nullG2diagnostic) GetPhysicalCores(ctx context.Context) (int, error) {
+	return 0, nil
+}
+
+func (g2diagnostic *nullG2diagnostic) GetSdkId(ctx context.Context) string {
+	return ""
+}
+
+func (g2diagnostic *nullG2diagnostic) GetTotalSystemMemory(ctx context.Context) (int64, error) {
+	return 0, nil
+}
+
+func (g2diagnostic *nullG2diagnostic) Init(ctx context.Context, moduleName string, iniParams string, verboseLogging int) error {
+	return nil
+}
+
+func (g2diagnostic *nullG2diagnostic) InitWithConfigID(ctx context.Context, moduleName string, iniParams string, initConfigID int64, verboseLogging int) error {
+	return nil
+}
+
+func (g2diagnostic *nullG2diagnostic) RegisterObserver(ctx context.Context, observer observer.Observer) error {
+	return nil
+}
+
+func (g2diagnostic *nullG2diagnostic) UnregisterObserver(ctx context.Context, observer observer.Observer) error {
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// nullG2engine
+// ----------------------------------------------------------------------------
+
+func (g2engine *nullG2engine) AddRecord(ctx context.Context, dataSourceCode string, recordID string, jsonData string, loadID string) error {
+	return nil
+}
+
+func (g2engine *nullG2engine) AddRecordWithInfo(ctx context.Context, dataSourceCode string, recordID string, jsonData string, loadID string, flags int64) (string, error) {
+	return "", nil
+}
+
+func (g2engine *nullG2engine) CloseExport(ctx context.Context, responseHandle uintptr) error {
+	return nil
+}
+
+func (g2engine *nullG2engine) CountRedoRecords(ctx context.Context) (int64, error) {
+	return 0, nil
+}
+
+func (g2engine *nullG2engine) Destroy(ctx context.Context) error {
+	return nil
+}
+
+func (g2engine *nullG2engine) ExportJSONEntityReport(ctx context.Context, flags int64) (uintptr, error) {
+	return 0, nil
+}
+
+func (g2engine *nullG2engine) FetchNext(ctx context.Context, responseHandle uintptr) (string, error) {
+	return "", nil
+}
+
+func (g2engine *nullG2engine) GetActiveConfigID(ctx context.Context) (int64, error) {
+	return 0, nil
+}
+
+func (g2engine *nullG2engine) GetEntityByEntityID(ctx context.Context, entityID int64) (string, error) {
+	return "", nil
+}
+
+func (g2engine *nullG2engine) GetEntityByRecordID(ctx context.Context, dataSourceCode string, recordID string) (string, error) {
+	return "", nil
+}
+
+func (g2engine *nullG2engine) GetRecord(ctx context.Context, dataSourceCode string, recordID string) (string, error) {
+	return "", nil
+}
+
+func (g2engine *nullG2engine) GetRedoRecord(ctx context.Context) (string, error) {
+	return "", nil
+}
+
+func (g2engine *nullG2engine) GetSdkId(ctx context.Context) string {
+	return ""
+}
+
+func (g2engine *nullG2engine) Init(ctx context.Context, moduleName string, iniParams string, verboseLogging int) error {
+	return nil
+}
+
+func (g2engine *nullG2engine) InitWithConfigID(ctx context.Context, moduleName string, iniParams string, initConfigID int64, verboseLogging int) error {
+	return nil
+}
+
+func (g2engine *nullG2engine) Process(ctx context.Context, record string) error {
+	return nil
+}
+
+func (g2engine *nullG2engine) ProcessWithInfo(ctx context.Context, record string, flags int64) (string, error) {
+	return "", nil
+}
+
+func (g2engine *nullG2engine) PurgeRepository(ctx context.Context) error {
+	return nil
+}
+
+func (g2engine *nullG2engine) RegisterObserver(ctx context.Context, observer observer.Observer) error {
+	return nil
+}
+
+func (g2engine *nullG2engine) SearchByAttributes(ctx context.Context, jsonData string) (string, error) {
+	return "", nil
+}
+
+func (g2engine *nullG2engine) Stats(ctx context.Context) (string, error) {
+	return "", nil
+}
+
+func (g2engine *nullG2engine) UnregisterObserver(ctx context.Context, observer observer.Observer) error {
+	return nil
+}
+
+func (g2engine *nullG2engine) WhyEntities(ctx context.Context, entityID1 int64, entityID2 int64) (string, error) {
+	return "", nil
+}
+
+// ----------------------------------------------------------------------------
+// nullG2product
+// ----------------------------------------------------------------------------
+
+func (g2product *nullG2product) Destroy(ctx context.Context) error {
+	return nil
+}
+
+func (g2product *nullG2product) GetSdkId(ctx context.Context) string {
+	return ""
+}
+
+func (g2product *nullG2product) Init(ctx context.Context, moduleName string, iniParams string, verboseLogging int) error {
+	return nil
+}
+
+func (g2product *nullG2product) License(ctx context.Context) (string, error) {
+	return "", nil
+}
+
+func (g2product *nullG2product) RegisterObserver(ctx context.Context, observer observer.Observer) error {
+	return nil
+}
+
+func (g2product *nullG2product) UnregisterObserver(ctx context.Context, observer observer.Observer) error {
+	return nil
+}
+
+func (g2product *nullG2product) Version(ctx context.Context) (string, error) {
+	return "", nil
+}
@@ -0,0 +1,44 @@
+package factory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestWithNullBackend(test *testing.T) {
+	ctx := context.TODO()
+	testObject, err := New(WithNullBackend())
+	testError(test, ctx, err)
+	defer testObject.Destroy(ctx)
+	assert.Equal(test, ModeNull, testObject.Mode())
+	assert.False(test, testObject.IsGrpc())
+
+	g2engine, err := testObject.GetG2engine(ctx)
+	testError(test, ctx, err)
+	assert.IsType(test, &nullG2engine{}, g2engine)
+	err = g2engine.AddRecord(ctx, "CUSTOMERS", "1001", `{"NAME_FULL":"Robert Smith"}`, "")
+	testError(test, ctx, err)
+	withInfo, err := g2engine.AddRecordWithInfo(ctx, "CUSTOMERS", "1001", `{"NAME_FULL":"Robert Smith"}`, "", 0)
+	testError(test, ctx, err)
+	assert.Empty(test, withInfo)
+
+	testError(test, ctx, testObject.HealthCheck(ctx))
+	testError(test, ctx, testObject.CheckCompatibility(ctx))
+	assert.Len(test, testObject.CreatedObjects(ctx), 5)
+}
+
+func TestWithNullBackend_ignoresOtherSettings(test *testing.T) {
+	ctx := context.TODO()
+	testObject, err := New(WithGrpcAddress("localhost:8258"), WithEngineConfigurationJson(iniParams), WithNullBackend())
+	testError(test, ctx, err)
+	g2engine, err := testObject.GetG2engineWithConfigID(ctx, 4015588140)
+	testError(test, ctx, err)
+	assert.IsType(test, &nullG2engine{}, g2engine)
+	testError(test, ctx, testObject.Destroy(ctx))
+}
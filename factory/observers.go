@@ -0,0 +1,231 @@
+package factory
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	"github.com/senzing/go-observing/observer"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// observable is the observer registration implemented by every G2* object.
+type observable interface {
+	RegisterObserver(ctx context.Context, observer observer.Observer) error
+	UnregisterObserver(ctx context.Context, observer observer.Observer) error
+}
+
+// observerMessage is a factory notification waiting in an observerQueue.
+type observerMessage struct {
+	ctx     context.Context
+	message string
+}
+
+// observerQueue delivers the factory's notifications to one observer from its own goroutine,
+// so that a slow observer does not delay the factory.  See WithObserverBuffer.
+type observerQueue struct {
+	done     chan struct{}
+	messages chan observerMessage
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Start delivering messages to anObserver from a buffer of size messages.
+func newObserverQueue(anObserver observer.Observer, size int) *observerQueue {
+	result := &observerQueue{
+		done:     make(chan struct{}),
+		messages: make(chan observerMessage, size),
+	}
+	go func() {
+		defer close(result.done)
+		for message := range result.messages {
+			anObserver.UpdateObserver(message.ctx, message.message)
+		}
+	}()
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Register the factory's observers on a newly created object and track it for later
+// RegisterObserver/UnregisterObserver calls.  Both happen under observersMutex so an
+// observer is registered on each object exactly once.
+func (factory *SdkAbstractFactoryImpl) addObservedObject(ctx context.Context, object observable) error {
+	factory.observersMutex.Lock()
+	defer factory.observersMutex.Unlock()
+	var errs []error
+	for _, anObserver := range factory.observers {
+		errs = append(errs, object.RegisterObserver(ctx, anObserver))
+	}
+	factory.observedObjects = append(factory.observedObjects, object)
+	return errors.Join(errs...)
+}
+
+// Notify the factory's observers of a factory lifecycle event.
+// The message is JSON in the format used by the Senzing SDK objects' own notifications,
+// with the messageId taken from the 8000-series of IdMessages.
+func (factory *SdkAbstractFactoryImpl) notify(ctx context.Context, messageId int, err error, details map[string]string) {
+	factory.observersMutex.Lock()
+	observers := append([]observer.Observer{}, factory.observers...)
+	factory.observersMutex.Unlock()
+	if len(observers) == 0 {
+		return
+	}
+	if len(factory.Environment) > 0 {
+		details["environment"] = factory.Environment
+	}
+	details["subjectId"] = strconv.Itoa(ProductId)
+	details["messageId"] = strconv.Itoa(messageId)
+	details["messageTime"] = strconv.FormatInt(factory.getClock().Now().UnixNano(), 10)
+	if err != nil {
+		details["error"] = err.Error()
+	}
+	message, err := json.Marshal(details)
+	if err != nil {
+		return
+	}
+	if factory.ObserverBufferSize > 0 {
+		factory.enqueueNotification(ctx, string(message))
+		return
+	}
+	for _, anObserver := range observers {
+		anObserver.UpdateObserver(ctx, string(message))
+	}
+}
+
+// Queue a notification for every observer without waiting for delivery.
+// A notification for an observer whose buffer is full is dropped, logged, and counted in Metrics.
+func (factory *SdkAbstractFactoryImpl) enqueueNotification(ctx context.Context, message string) {
+	factory.observersMutex.Lock()
+	defer factory.observersMutex.Unlock()
+	if factory.observerQueues == nil {
+		factory.observerQueues = map[observer.Observer]*observerQueue{}
+	}
+	for _, anObserver := range factory.observers {
+		queue, ok := factory.observerQueues[anObserver]
+		if !ok {
+			queue = newObserverQueue(anObserver, factory.ObserverBufferSize)
+			factory.observerQueues[anObserver] = queue
+		}
+		select {
+		case queue.messages <- observerMessage{ctx: ctx, message: message}:
+		default:
+			observerId := anObserver.GetObserverId(ctx)
+			factory.getLogger().Log(3003, observerId, factory.ObserverBufferSize)
+			if factory.Metrics != nil {
+				factory.Metrics.ObserverNotificationDropped(observerId)
+			}
+		}
+	}
+}
+
+// Deliver the queued notifications and stop the delivery goroutines, e.g. in Destroy.
+// Queues are started again by the next notification.
+func (factory *SdkAbstractFactoryImpl) flushNotifications() {
+	factory.observersMutex.Lock()
+	queues := factory.observerQueues
+	factory.observerQueues = nil
+	factory.observersMutex.Unlock()
+	for _, queue := range queues {
+		close(queue.messages)
+	}
+	for _, queue := range queues {
+		<-queue.done
+	}
+}
+
+// Forget a single tracked object, e.g. after DestroyG2engine.
+func (factory *SdkAbstractFactoryImpl) removeObservedObject(object observable) {
+	factory.observersMutex.Lock()
+	defer factory.observersMutex.Unlock()
+	for index, observedObject := range factory.observedObjects {
+		if observedObject == object {
+			factory.observedObjects = append(factory.observedObjects[:index], factory.observedObjects[index+1:]...)
+			return
+		}
+	}
+}
+
+// Forget the tracked objects, e.g. after Destroy.  The observers are kept.
+func (factory *SdkAbstractFactoryImpl) clearObservedObjects() {
+	factory.observersMutex.Lock()
+	defer factory.observersMutex.Unlock()
+	factory.observedObjects = nil
+}
+
+// ----------------------------------------------------------------------------
+// Interface methods
+// ----------------------------------------------------------------------------
+
+/*
+The RegisterObserver method adds an observer to every Senzing object the factory
+has created, and to every object it creates later.
+The observer is also notified of the factory's own lifecycle events: the creation of
+each G2* object, Destroy, and Reset (message IDs 8001-8007), and of an expired or
+soon-expiring license found by Initialize (8008 and 8009).
+Registering the same observer twice has no effect.
+
+Input
+  - ctx: A context to control lifecycle.
+  - observer: The observer to be notified of Senzing object events.
+
+Output
+  - All errors returned by the objects' RegisterObserver methods, joined with errors.Join.
+*/
+func (factory *SdkAbstractFactoryImpl) RegisterObserver(ctx context.Context, observer observer.Observer) error {
+	ctx = factory.getContext(ctx)
+	factory.observersMutex.Lock()
+	defer factory.observersMutex.Unlock()
+	for _, registered := range factory.observers {
+		if registered == observer {
+			return nil
+		}
+	}
+	factory.observers = append(factory.observers, observer)
+	var errs []error
+	for _, object := range factory.observedObjects {
+		errs = append(errs, object.RegisterObserver(ctx, observer))
+	}
+	return errors.Join(errs...)
+}
+
+/*
+The UnregisterObserver method removes an observer from every Senzing object the
+factory has created, and stops adding it to objects created later.
+With WithObserverBuffer, notifications already queued for the observer are still delivered.
+
+Input
+  - ctx: A context to control lifecycle.
+  - observer: The observer to be removed.
+
+Output
+  - All errors returned by the objects' UnregisterObserver methods, joined with errors.Join.
+*/
+func (factory *SdkAbstractFactoryImpl) UnregisterObserver(ctx context.Context, observer observer.Observer) error {
+	ctx = factory.getContext(ctx)
+	factory.observersMutex.Lock()
+	defer factory.observersMutex.Unlock()
+	for index, registered := range factory.observers {
+		if registered == observer {
+			factory.observers = append(factory.observers[:index], factory.observers[index+1:]...)
+			if queue, ok := factory.observerQueues[observer]; ok {
+				close(queue.messages)
+				delete(factory.observerQueues, observer)
+			}
+			var errs []error
+			for _, object := range factory.observedObjects {
+				errs = append(errs, object.UnregisterObserver(ctx, observer))
+			}
+			return errors.Join(errs...)
+		}
+	}
+	return nil
+}
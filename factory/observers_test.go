@@ -0,0 +1,236 @@
+package factory
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/senzing/g2-sdk-go/g2api"
+	"github.com/senzing/go-observing/observer"
+	"github.com/stretchr/testify/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Test doubles
+// ----------------------------------------------------------------------------
+
+// observableG2engine notifies its observers when Process is called.
+type observableG2engine struct {
+	g2api.G2engine
+	observers []observer.Observer
+}
+
+func (g2engine *observableG2engine) Process(ctx context.Context, record string) error {
+	for _, anObserver := range g2engine.observers {
+		anObserver.UpdateObserver(ctx, record)
+	}
+	return nil
+}
+
+func (g2engine *observableG2engine) RegisterObserver(ctx context.Context, observer observer.Observer) error {
+	g2engine.observers = append(g2engine.observers, observer)
+	return nil
+}
+
+func (g2engine *observableG2engine) UnregisterObserver(ctx context.Context, observer observer.Observer) error {
+	for index, registered := range g2engine.observers {
+		if registered == observer {
+			g2engine.observers = append(g2engine.observers[:index], g2engine.observers[index+1:]...)
+		}
+	}
+	return nil
+}
+
+// recordingObserver remembers the messages it receives.
+type recordingObserver struct {
+	lock     sync.Mutex
+	messages []string
+}
+
+// Count the received messages having messageId.
+func (observer *recordingObserver) countMessageId(test *testing.T, messageId string) int {
+	observer.lock.Lock()
+	defer observer.lock.Unlock()
+	result := 0
+	for _, message := range observer.messages {
+		details := map[string]string{}
+		if json.Unmarshal([]byte(message), &details) == nil && details["messageId"] == messageId {
+			result++
+		}
+	}
+	return result
+}
+
+func (observer *recordingObserver) GetObserverId(ctx context.Context) string {
+	return "recordingObserver"
+}
+
+func (observer *recordingObserver) UpdateObserver(ctx context.Context, message string) {
+	observer.lock.Lock()
+	defer observer.lock.Unlock()
+	observer.messages = append(observer.messages, message)
+}
+
+// slowObserver records messages, but only once release is closed.
+type slowObserver struct {
+	recordingObserver
+	release chan struct{}
+}
+
+func (observer *slowObserver) UpdateObserver(ctx context.Context, message string) {
+	<-observer.release
+	observer.recordingObserver.UpdateObserver(ctx, message)
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSdkAbstractFactoryImpl_RegisterObserver_createdObject(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &observableG2engine{}
+	testObject := getTestObjectWithG2engine(g2engine)
+	anObserver := &recordingObserver{}
+	testError(test, ctx, testObject.RegisterObserver(ctx, anObserver))
+	testError(test, ctx, testObject.RegisterObserver(ctx, anObserver))
+	testError(test, ctx, g2engine.Process(ctx, "event"))
+	assert.Equal(test, []string{"event"}, anObserver.messages)
+}
+
+func TestSdkAbstractFactoryImpl_RegisterObserver_laterObject(test *testing.T) {
+	ctx := context.TODO()
+	testObject := &SdkAbstractFactoryImpl{}
+	anObserver := &recordingObserver{}
+	testError(test, ctx, testObject.RegisterObserver(ctx, anObserver))
+	g2engine := &observableG2engine{}
+	testError(test, ctx, testObject.addObservedObject(ctx, g2engine))
+	testError(test, ctx, g2engine.Process(ctx, "event"))
+	assert.Equal(test, []string{"event"}, anObserver.messages)
+}
+
+func TestSdkAbstractFactoryImpl_UnregisterObserver(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &observableG2engine{}
+	testObject := getTestObjectWithG2engine(g2engine)
+	anObserver := &recordingObserver{}
+	testError(test, ctx, testObject.RegisterObserver(ctx, anObserver))
+	testError(test, ctx, testObject.UnregisterObserver(ctx, anObserver))
+	testError(test, ctx, g2engine.Process(ctx, "event"))
+	assert.Empty(test, anObserver.messages)
+	assert.Empty(test, testObject.observers)
+}
+
+func TestSdkAbstractFactoryImpl_notify_g2engineCreated(test *testing.T) {
+	ctx := context.TODO()
+	testObject := &SdkAbstractFactoryImpl{
+		GrpcAddress: "localhost:8258",
+	}
+	anObserver := &recordingObserver{}
+	testError(test, ctx, testObject.RegisterObserver(ctx, anObserver))
+	var waitGroup sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			_, err := testObject.GetG2engine(ctx)
+			assert.NoError(test, err)
+		}()
+	}
+	waitGroup.Wait()
+	assert.Equal(test, 1, anObserver.countMessageId(test, "8004"))
+
+	testError(test, ctx, testObject.Reset(ctx, true))
+	assert.Equal(test, 1, anObserver.countMessageId(test, "8006"))
+	assert.Equal(test, 1, anObserver.countMessageId(test, "8007"))
+}
+
+func TestSdkAbstractFactoryImpl_notify_fanOut(test *testing.T) {
+	ctx := context.TODO()
+	testObject, err := New(WithNullBackend())
+	testError(test, ctx, err)
+	auditObserver := &recordingObserver{}
+	metricsObserver := &recordingObserver{}
+	testError(test, ctx, testObject.RegisterObserver(ctx, auditObserver))
+	testError(test, ctx, testObject.RegisterObserver(ctx, metricsObserver))
+	_, err = testObject.GetAll(ctx)
+	testError(test, ctx, err)
+	for _, messageId := range []string{"8001", "8002", "8003", "8004", "8005"} {
+		assert.Equal(test, 1, auditObserver.countMessageId(test, messageId), messageId)
+		assert.Equal(test, 1, metricsObserver.countMessageId(test, messageId), messageId)
+	}
+}
+
+func TestSdkAbstractFactoryImpl_notify_fanOutSlowObserver(test *testing.T) {
+	ctx := context.TODO()
+	testObject, err := New(WithNullBackend(), WithObserverBuffer(8))
+	testError(test, ctx, err)
+	auditObserver := &slowObserver{release: make(chan struct{})}
+	metricsObserver := &recordingObserver{}
+	testError(test, ctx, testObject.RegisterObserver(ctx, auditObserver))
+	testError(test, ctx, testObject.RegisterObserver(ctx, metricsObserver))
+	_, err = testObject.GetAll(ctx)
+	testError(test, ctx, err)
+
+	// The metrics observer receives every creation event while the audit observer is stuck.
+	assert.Eventually(test, func() bool {
+		return metricsObserver.countMessageId(test, "8001") == 1 && metricsObserver.countMessageId(test, "8005") == 1
+	}, 5*time.Second, time.Millisecond)
+	assert.Equal(test, 0, auditObserver.countMessageId(test, "8001"))
+
+	close(auditObserver.release)
+	testError(test, ctx, testObject.Destroy(ctx))
+	for _, messageId := range []string{"8001", "8002", "8003", "8004", "8005"} {
+		assert.Equal(test, 1, auditObserver.countMessageId(test, messageId), messageId)
+		assert.Equal(test, 1, metricsObserver.countMessageId(test, messageId), messageId)
+	}
+}
+
+func TestSdkAbstractFactoryImpl_notify_observerBuffer(test *testing.T) {
+	ctx := context.TODO()
+	logger := &recordingLogger{}
+	testObject := &SdkAbstractFactoryImpl{logger: logger, NullBackend: true}
+	testError(test, ctx, WithObserverBuffer(2)(testObject))
+	metrics := &recordingMetrics{}
+	testError(test, ctx, WithMetrics(metrics)(testObject))
+	anObserver := &slowObserver{release: make(chan struct{})}
+	testError(test, ctx, testObject.RegisterObserver(ctx, anObserver))
+
+	// Of the five creation notifications, one is being delivered, two are buffered, and the
+	// rest are dropped; the first may also still be buffered when the others arrive.
+	done := make(chan error)
+	go func() {
+		_, err := testObject.GetAll(ctx)
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		testError(test, ctx, err)
+	case <-time.After(5 * time.Second):
+		close(anObserver.release)
+		test.Fatal("a slow observer blocked the getters")
+	}
+	dropped := 0
+	for _, messageNumber := range logger.messageNumbers {
+		if messageNumber == 3003 {
+			dropped++
+		}
+	}
+	assert.Contains(test, []int{2, 3}, dropped)
+	assert.Equal(test, dropped, metrics.count("dropped", "recordingObserver"))
+
+	close(anObserver.release)
+	assert.Eventually(test, func() bool {
+		anObserver.lock.Lock()
+		defer anObserver.lock.Unlock()
+		return len(anObserver.messages) == 5-dropped
+	}, 5*time.Second, time.Millisecond)
+	testError(test, ctx, testObject.Destroy(ctx))
+	assert.Equal(test, 1, anObserver.countMessageId(test, "8001"))
+	assert.Equal(test, 1, anObserver.countMessageId(test, "8006"), "Destroy must deliver its own notification before returning")
+}
+
+func TestWithObserverBuffer_invalid(test *testing.T) {
+	assert.Error(test, WithObserverBuffer(0)(&SdkAbstractFactoryImpl{}))
+}
@@ -0,0 +1,46 @@
+package factory
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// successOnce is like sync.Once, except that a call to Do whose function fails does not
+// count: the next call to Do runs the function again.  This keeps a transient error, such
+// as an unreachable gRPC server, from permanently breaking a lazily created singleton.
+// The zero value is ready to use; assigning successOnce{} resets it.
+type successOnce struct {
+	done  uint32
+	mutex sync.Mutex
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Run f unless an earlier call to Do has succeeded.  Concurrent callers wait for the
+// running call to finish.  Returns the error from f, or nil if f did not need to run.
+func (once *successOnce) Do(f func() error) error {
+	if atomic.LoadUint32(&once.done) == 1 {
+		return nil
+	}
+	once.mutex.Lock()
+	defer once.mutex.Unlock()
+	if once.done == 1 {
+		return nil
+	}
+	err := f()
+	if err == nil {
+		atomic.StoreUint32(&once.done, 1)
+	}
+	return err
+}
+
+// Report whether a call to Do has succeeded.
+func (once *successOnce) isDone() bool {
+	return atomic.LoadUint32(&once.done) == 1
+}
@@ -0,0 +1,31 @@
+package factory
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSuccessOnce_Do(test *testing.T) {
+	testObject := successOnce{}
+	callCount := 0
+	failure := errors.New("transient")
+	err := testObject.Do(func() error {
+		callCount++
+		return failure
+	})
+	assert.ErrorIs(test, err, failure)
+	for i := 0; i < 2; i++ {
+		err = testObject.Do(func() error {
+			callCount++
+			return nil
+		})
+		assert.NoError(test, err)
+	}
+	assert.Equal(test, 2, callCount, "a failed call is retried; a successful call is not")
+}
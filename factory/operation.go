@@ -0,0 +1,152 @@
+package factory
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// OperationKind names a kind of Senzing operation, for the defaults OperationContext applies.
+type OperationKind int
+
+// Operation describes the operation a context was prepared for by OperationContext.
+type Operation struct {
+	CorrelationID string
+	Kind          OperationKind
+	Verbose       bool
+}
+
+// operationContextKey is the context key for the Operation set by OperationContext.
+type operationContextKey struct{}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const (
+	// OperationRead covers lookups and searches, such as GetEntityByEntityID and SearchByAttributes.
+	OperationRead OperationKind = iota
+	// OperationWrite covers record changes and redo processing, such as AddRecord and ProcessRedoRecords.
+	OperationWrite
+	// OperationExport covers exports, such as ExportEntities.
+	OperationExport
+	// OperationAdmin covers configuration, statistics, and maintenance, such as EnsureDefaultConfig.
+	OperationAdmin
+)
+
+// CorrelationIDMetadataKey is the gRPC metadata key of the correlation identifier added by OperationContext.
+const CorrelationIDMetadataKey = "x-correlation-id"
+
+// ----------------------------------------------------------------------------
+// Functions
+// ----------------------------------------------------------------------------
+
+// OperationFromContext returns the Operation carried by ctx, and false if there is none.
+func OperationFromContext(ctx context.Context) (Operation, bool) {
+	operation, ok := ctx.Value(operationContextKey{}).(Operation)
+	return operation, ok
+}
+
+// Return a random correlation identifier, 32 hexadecimal digits.
+func newCorrelationID() string {
+	buffer := make([]byte, 16)
+	if _, err := rand.Read(buffer); err != nil {
+		return fmt.Sprintf("%032x", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(buffer)
+}
+
+// Return the OperationKind named by text, as returned by String.
+func parseOperationKind(text string) (OperationKind, error) {
+	for _, kind := range []OperationKind{OperationRead, OperationWrite, OperationExport, OperationAdmin} {
+		if kind.String() == text {
+			return kind, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown operation kind %q", text)
+}
+
+// ----------------------------------------------------------------------------
+// Interface methods
+// ----------------------------------------------------------------------------
+
+// String returns "read", "write", "export", or "admin".
+func (kind OperationKind) String() string {
+	switch kind {
+	case OperationRead:
+		return "read"
+	case OperationWrite:
+		return "write"
+	case OperationExport:
+		return "export"
+	case OperationAdmin:
+		return "admin"
+	}
+	return "unknown"
+}
+
+func (kind OperationKind) MarshalText() ([]byte, error) {
+	if _, err := parseOperationKind(kind.String()); err != nil {
+		return nil, err
+	}
+	return []byte(kind.String()), nil
+}
+
+func (kind *OperationKind) UnmarshalText(text []byte) error {
+	parsed, err := parseOperationKind(string(text))
+	if err != nil {
+		return err
+	}
+	*kind = parsed
+	return nil
+}
+
+/*
+The OperationContext method prepares ctx for an operation of kind op, so that callers
+do not each repeat the same setup:
+  - The timeout set for op with WithOperationTimeout, if any, bounds the operation;
+    an earlier deadline already on ctx is kept.
+  - An Operation, returned by OperationFromContext, records op, whether VerboseLogging is set,
+    and a correlation identifier: the one of an enclosing OperationContext, or a new one.
+  - In gRPC mode, the correlation identifier is sent as CorrelationIDMetadataKey metadata,
+    unless ctx already carries that key.
+
+The deadline's resources are released when it passes or ctx is cancelled.
+
+Input
+  - ctx: A context to control lifecycle.
+  - op: The kind of operation the context is for.
+
+Output
+  - A copy of ctx with the operation's defaults.
+*/
+func (factory *SdkAbstractFactoryImpl) OperationContext(ctx context.Context, op OperationKind) context.Context {
+	ctx = factory.getContext(ctx)
+	operation := Operation{
+		Kind:    op,
+		Verbose: factory.VerboseLogging > 0,
+	}
+	if enclosing, ok := OperationFromContext(ctx); ok {
+		operation.CorrelationID = enclosing.CorrelationID
+	} else {
+		operation.CorrelationID = newCorrelationID()
+	}
+	ctx = context.WithValue(ctx, operationContextKey{}, operation)
+	if outgoing, _ := metadata.FromOutgoingContext(ctx); factory.IsGrpc() && len(outgoing.Get(CorrelationIDMetadataKey)) == 0 {
+		ctx = metadata.AppendToOutgoingContext(ctx, CorrelationIDMetadataKey, operation.CorrelationID)
+	}
+	if timeout := factory.OperationTimeouts[op]; timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		time.AfterFunc(timeout, cancel)
+	}
+	return ctx
+}
@@ -0,0 +1,85 @@
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSdkAbstractFactoryImpl_OperationContext(test *testing.T) {
+	result, err := New(WithNullBackend(), WithOperationTimeout(OperationWrite, time.Minute), WithVerboseLogging(1))
+	testError(test, context.TODO(), err)
+	testObject := result.(*SdkAbstractFactoryImpl)
+	parent, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	ctx := testObject.OperationContext(parent, OperationWrite)
+	operation, ok := OperationFromContext(ctx)
+	assert.True(test, ok)
+	assert.Equal(test, OperationWrite, operation.Kind)
+	assert.True(test, operation.Verbose)
+	assert.Len(test, operation.CorrelationID, 32)
+	deadline, ok := ctx.Deadline()
+	assert.True(test, ok)
+	assert.WithinDuration(test, time.Now().Add(time.Minute), deadline, 5*time.Second)
+	_, ok = metadata.FromOutgoingContext(ctx)
+	assert.False(test, ok, "only gRPC calls carry the correlation identifier")
+
+	// A nested operation keeps the correlation identifier; a kind without a timeout adds no deadline.
+
+	nested, ok := OperationFromContext(testObject.OperationContext(ctx, OperationRead))
+	assert.True(test, ok)
+	assert.Equal(test, OperationRead, nested.Kind)
+	assert.Equal(test, operation.CorrelationID, nested.CorrelationID)
+	_, ok = testObject.OperationContext(parent, OperationRead).Deadline()
+	assert.False(test, ok)
+	other, _ := OperationFromContext(testObject.OperationContext(parent, OperationRead))
+	assert.NotEqual(test, operation.CorrelationID, other.CorrelationID)
+}
+
+func TestSdkAbstractFactoryImpl_OperationContext_earlierDeadline(test *testing.T) {
+	testObject := &SdkAbstractFactoryImpl{OperationTimeouts: map[OperationKind]time.Duration{OperationExport: time.Hour}}
+	parent, cancel := context.WithTimeout(context.TODO(), time.Minute)
+	defer cancel()
+	expected, _ := parent.Deadline()
+	actual, _ := testObject.OperationContext(parent, OperationExport).Deadline()
+	assert.Equal(test, expected, actual)
+}
+
+func TestSdkAbstractFactoryImpl_OperationContext_grpc(test *testing.T) {
+	testObject := &SdkAbstractFactoryImpl{GrpcAddress: "localhost:8261"}
+	ctx := testObject.OperationContext(context.TODO(), OperationAdmin)
+	operation, _ := OperationFromContext(ctx)
+	outgoing, _ := metadata.FromOutgoingContext(ctx)
+	assert.Equal(test, []string{operation.CorrelationID}, outgoing.Get(CorrelationIDMetadataKey))
+
+	// A correlation identifier already attached by the caller is kept.
+
+	ctx = metadata.AppendToOutgoingContext(context.TODO(), CorrelationIDMetadataKey, "caller-id")
+	outgoing, _ = metadata.FromOutgoingContext(testObject.OperationContext(ctx, OperationAdmin))
+	assert.Equal(test, []string{"caller-id"}, outgoing.Get(CorrelationIDMetadataKey))
+}
+
+func TestOperationKind_String(test *testing.T) {
+	for _, kind := range []OperationKind{OperationRead, OperationWrite, OperationExport, OperationAdmin} {
+		text, err := kind.MarshalText()
+		testError(test, context.TODO(), err)
+		var parsed OperationKind
+		testError(test, context.TODO(), parsed.UnmarshalText(text))
+		assert.Equal(test, kind, parsed)
+	}
+	assert.Equal(test, "unknown", OperationKind(42).String())
+	assert.Error(test, new(OperationKind).UnmarshalText([]byte("unknown")))
+}
+
+func TestWithOperationTimeout_invalid(test *testing.T) {
+	assert.Error(test, WithOperationTimeout(OperationRead, 0)(&SdkAbstractFactoryImpl{}))
+	assert.Error(test, WithOperationTimeout(OperationKind(42), time.Second)(&SdkAbstractFactoryImpl{}))
+}
@@ -0,0 +1,164 @@
+package factory
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestNew(test *testing.T) {
+	actual, err := New(
+		WithModuleName(moduleName),
+		WithEngineConfigurationJson(iniParams),
+		WithVerboseLogging(verboseLogging),
+	)
+	assert.NoError(test, err)
+	sdkAbstractFactoryImpl := actual.(*SdkAbstractFactoryImpl)
+	assert.Equal(test, moduleName, sdkAbstractFactoryImpl.ModuleName)
+	assert.Equal(test, iniParams, sdkAbstractFactoryImpl.EngineConfigurationJson)
+	assert.Empty(test, sdkAbstractFactoryImpl.GrpcAddress)
+}
+
+func TestNew_grpc(test *testing.T) {
+	actual, err := New(WithGrpcAddress("localhost:8258"))
+	assert.NoError(test, err)
+	assert.Equal(test, "localhost:8258", actual.(*SdkAbstractFactoryImpl).GrpcAddress)
+}
+
+func TestNew_conflictingConfiguration(test *testing.T) {
+	actual, err := New(
+		WithGrpcAddress("localhost:8258"),
+		WithEngineConfigurationJson(`{"PIPELINE": {}}`),
+	)
+	assert.ErrorIs(test, err, ErrConflictingConfiguration)
+	assert.Nil(test, actual)
+}
+
+func TestNew_grpcDialerAndConnection(test *testing.T) {
+	dialer := func(ctx context.Context) (*grpc.ClientConn, error) {
+		return nil, nil
+	}
+	_, err := New(WithGrpcDialer(dialer), WithGrpcConnection(&grpc.ClientConn{}))
+	assert.ErrorIs(test, err, ErrConflictingConfiguration)
+}
+
+func TestNewFromEnv(test *testing.T) {
+	test.Setenv("SENZING_ENGINE_CONFIGURATION_JSON", iniParams)
+	test.Setenv("SENZING_GRPC_ADDRESS", "")
+	test.Setenv("SENZING_MODULE_NAME", moduleName)
+	test.Setenv("SENZING_VERBOSE_LOGGING", "1")
+	actual, err := NewFromEnv()
+	assert.NoError(test, err)
+	assert.Equal(test, ModeLocal, actual.Mode())
+	sdkAbstractFactoryImpl := actual.(*SdkAbstractFactoryImpl)
+	assert.Equal(test, iniParams, sdkAbstractFactoryImpl.EngineConfigurationJson)
+	assert.Equal(test, moduleName, sdkAbstractFactoryImpl.ModuleName)
+	assert.Equal(test, 1, sdkAbstractFactoryImpl.VerboseLogging)
+}
+
+func TestNewFromEnv_defaults(test *testing.T) {
+	test.Setenv("SENZING_ENGINE_CONFIGURATION_JSON", iniParams)
+	test.Setenv("SENZING_GRPC_ADDRESS", "")
+	test.Setenv("SENZING_MODULE_NAME", "")
+	test.Setenv("SENZING_VERBOSE_LOGGING", "")
+	actual, err := NewFromEnv()
+	assert.NoError(test, err)
+	sdkAbstractFactoryImpl := actual.(*SdkAbstractFactoryImpl)
+	assert.Equal(test, DefaultModuleName, sdkAbstractFactoryImpl.ModuleName)
+	assert.Equal(test, 0, sdkAbstractFactoryImpl.VerboseLogging)
+}
+
+func TestNewFromEnv_grpc(test *testing.T) {
+	test.Setenv("SENZING_ENGINE_CONFIGURATION_JSON", "")
+	test.Setenv("SENZING_GRPC_ADDRESS", "localhost:8258")
+	actual, err := NewFromEnv()
+	assert.NoError(test, err)
+	assert.Equal(test, ModeGrpc, actual.Mode())
+	assert.Equal(test, "localhost:8258", actual.(*SdkAbstractFactoryImpl).GrpcAddress)
+}
+
+func TestNewFromEnv_invalid(test *testing.T) {
+	testCases := []struct {
+		name                    string
+		engineConfigurationJson string
+		grpcAddress             string
+		verboseLogging          string
+		expected                string
+	}{
+		{name: "neither", expected: "set SENZING_GRPC_ADDRESS or SENZING_ENGINE_CONFIGURATION_JSON"},
+		{name: "both", engineConfigurationJson: iniParams, grpcAddress: "localhost:8258", expected: "conflicting factory configuration"},
+		{name: "verboseLogging", grpcAddress: "localhost:8258", verboseLogging: "yes", expected: "SENZING_VERBOSE_LOGGING must be a non-negative integer"},
+	}
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func(test *testing.T) {
+			test.Setenv("SENZING_ENGINE_CONFIGURATION_JSON", testCase.engineConfigurationJson)
+			test.Setenv("SENZING_GRPC_ADDRESS", testCase.grpcAddress)
+			test.Setenv("SENZING_VERBOSE_LOGGING", testCase.verboseLogging)
+			actual, err := NewFromEnv()
+			assert.ErrorContains(test, err, testCase.expected)
+			assert.Nil(test, actual)
+		})
+	}
+}
+
+func TestWithEngineConfigurationFile(test *testing.T) {
+	ctx := context.TODO()
+	engineConfigurationJson := `{"PIPELINE": {"CONFIGPATH": "/etc/opt/senzing", "RESOURCEPATH": "/opt/senzing/g2/resources", "SUPPORTPATH": "/opt/senzing/data"}, "SQL": {"CONNECTION": "sqlite3://na:na@/tmp/sqlite/G2C.db"}}`
+	path := filepath.Join(test.TempDir(), "engine-configuration.json")
+	err := os.WriteFile(path, []byte(engineConfigurationJson), 0600)
+	testError(test, ctx, err)
+	actual, err := New(WithEngineConfigurationFile(path))
+	testError(test, ctx, err)
+	assert.Equal(test, engineConfigurationJson, actual.(*SdkAbstractFactoryImpl).EngineConfigurationJson)
+}
+
+func TestWithEngineConfigurationFile_missingFile(test *testing.T) {
+	_, err := New(WithEngineConfigurationFile(filepath.Join(test.TempDir(), "missing.json")))
+	assert.ErrorIs(test, err, fs.ErrNotExist)
+}
+
+func TestWithEngineConfigurationFile_malformed(test *testing.T) {
+	ctx := context.TODO()
+	path := filepath.Join(test.TempDir(), "engine-configuration.json")
+	err := os.WriteFile(path, []byte(`{"PIPELINE": {`), 0600)
+	testError(test, ctx, err)
+	_, err = New(WithEngineConfigurationFile(path))
+	assert.ErrorIs(test, err, ErrInvalidEngineConfiguration)
+	assert.ErrorContains(test, err, path)
+}
+
+func TestNew_invalidOption(test *testing.T) {
+	_, err := New(WithCircuitBreaker(CircuitBreakerSettings{}))
+	assert.Error(test, err)
+}
+
+func TestNew_invalidEngineConfiguration(test *testing.T) {
+	testCases := []struct {
+		name                    string
+		engineConfigurationJson string
+		expected                string
+	}{
+		{name: "empty", engineConfigurationJson: "", expected: "invalid engine configuration: empty"},
+		{name: "notJson", engineConfigurationJson: "PIPELINE=/opt/senzing", expected: "invalid engine configuration: invalid character"},
+		{name: "notObjects", engineConfigurationJson: `{"PIPELINE": "/opt/senzing"}`, expected: "invalid engine configuration: json: cannot unmarshal"},
+		{name: "missingSection", engineConfigurationJson: `{"PIPELINE": {"CONFIGPATH": "/etc/opt/senzing", "RESOURCEPATH": "/opt/senzing/g2/resources", "SUPPORTPATH": "/opt/senzing/data"}}`, expected: "invalid engine configuration: missing SQL.CONNECTION"},
+		{name: "missingKey", engineConfigurationJson: `{"PIPELINE": {"CONFIGPATH": "/etc/opt/senzing"}, "SQL": {"CONNECTION": "sqlite3://na:na@/tmp/sqlite/G2C.db"}}`, expected: "invalid engine configuration: missing PIPELINE.RESOURCEPATH"},
+	}
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func(test *testing.T) {
+			actual, err := New(WithEngineConfigurationJson(testCase.engineConfigurationJson))
+			assert.ErrorIs(test, err, ErrInvalidEngineConfiguration)
+			assert.ErrorContains(test, err, testCase.expected)
+			assert.Nil(test, actual)
+		})
+	}
+}
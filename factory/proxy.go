@@ -0,0 +1,101 @@
+package factory
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// bufferedConn returns the bytes read ahead while parsing the proxy's response before
+// reading from the connection again.
+type bufferedConn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+func (conn *bufferedConn) Read(buffer []byte) (int, error) {
+	return conn.reader.Read(buffer)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Ask the HTTP proxy at the other end of conn to tunnel to address.
+func connectThroughProxy(ctx context.Context, conn net.Conn, proxyURL *url.URL, address string) (net.Conn, error) {
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+		defer conn.SetDeadline(time.Time{})
+	}
+	request := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Host: address},
+		Host:   address,
+		Header: http.Header{},
+	}
+	if proxyURL.User != nil {
+		password, _ := proxyURL.User.Password()
+		credentials := base64.StdEncoding.EncodeToString([]byte(proxyURL.User.Username() + ":" + password))
+		request.Header.Set("Proxy-Authorization", "Basic "+credentials)
+	}
+	if err := request.Write(conn); err != nil {
+		return nil, fmt.Errorf("cannot send CONNECT %s to proxy %s: %w", address, proxyURL.Host, err)
+	}
+	reader := bufio.NewReader(conn)
+	response, err := http.ReadResponse(reader, request)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read the response to CONNECT %s from proxy %s: %w", address, proxyURL.Host, err)
+	}
+	response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("proxy %s refused CONNECT %s: %s", proxyURL.Host, address, response.Status)
+	}
+	if reader.Buffered() > 0 {
+		return &bufferedConn{Conn: conn, reader: reader}, nil
+	}
+	return conn, nil
+}
+
+// Return a dialer for grpc.WithContextDialer that tunnels each TCP connection through the
+// HTTP CONNECT proxy chosen by proxy, or dials directly when proxy returns nil.
+func proxyDialer(proxy func(address string) (*url.URL, error)) func(ctx context.Context, address string) (net.Conn, error) {
+	return func(ctx context.Context, address string) (net.Conn, error) {
+		proxyURL, err := proxy(address)
+		if err != nil {
+			return nil, err
+		}
+		dialer := net.Dialer{}
+		if proxyURL == nil {
+			return dialer.DialContext(ctx, "tcp", address)
+		}
+		conn, err := dialer.DialContext(ctx, "tcp", proxyURL.Host)
+		if err != nil {
+			return nil, fmt.Errorf("cannot connect to proxy %s: %w", proxyURL.Host, err)
+		}
+		tunnel, err := connectThroughProxy(ctx, conn, proxyURL, address)
+		if err != nil {
+			conn.Close()
+			return nil, err
+		}
+		return tunnel, nil
+	}
+}
+
+// Choose a proxy from the HTTPS_PROXY and NO_PROXY environment variables, and their lowercase
+// forms, as net/http does.  As there, the variables are read once per process.
+func proxyFromEnvironment(address string) (*url.URL, error) {
+	return http.ProxyFromEnvironment(&http.Request{URL: &url.URL{Scheme: "https", Host: address}})
+}
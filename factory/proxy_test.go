@@ -0,0 +1,115 @@
+package factory
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------------------------------------------------------------
+// Test doubles
+// ----------------------------------------------------------------------------
+
+// connectProxy is a minimal HTTP CONNECT proxy recording the targets it tunnels to.
+type connectProxy struct {
+	mutex   sync.Mutex
+	targets []string
+}
+
+func (proxy *connectProxy) getTargets() []string {
+	proxy.mutex.Lock()
+	defer proxy.mutex.Unlock()
+	return append([]string{}, proxy.targets...)
+}
+
+func (proxy *connectProxy) serve(conn net.Conn) {
+	defer conn.Close()
+	request, err := http.ReadRequest(bufio.NewReader(conn))
+	if err != nil || request.Method != http.MethodConnect {
+		return
+	}
+	proxy.mutex.Lock()
+	proxy.targets = append(proxy.targets, request.Host)
+	proxy.mutex.Unlock()
+	target, err := net.Dial("tcp", request.Host)
+	if err != nil {
+		io.WriteString(conn, "HTTP/1.1 502 Bad Gateway\r\n\r\n")
+		return
+	}
+	defer target.Close()
+	io.WriteString(conn, "HTTP/1.1 200 Connection established\r\n\r\n")
+	go io.Copy(target, conn)
+	io.Copy(conn, target)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Start a connectProxy, returning it and its address.
+func startConnectProxy(test *testing.T) (*connectProxy, string) {
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(test, err)
+	test.Cleanup(func() { listener.Close() })
+	proxy := &connectProxy{}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go proxy.serve(conn)
+		}
+	}()
+	return proxy, listener.Addr().String()
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestWithProxy(test *testing.T) {
+	ctx := context.TODO()
+	proxy, proxyAddress := startConnectProxy(test)
+	grpcAddress := startTestGrpcServer(test)
+	testObject, err := New(WithGrpcAddress(grpcAddress), WithProxy("http://"+proxyAddress))
+	testError(test, ctx, err)
+	defer testObject.Destroy(ctx)
+	err = checkTestGrpcConnection(ctx, testObject)
+	testError(test, ctx, err)
+	assert.Equal(test, []string{grpcAddress}, proxy.getTargets())
+}
+
+func TestWithProxy_invalid(test *testing.T) {
+	for _, proxyURL := range []string{"proxy.example.com:3128", "socks5://proxy.example.com:1080", "http://"} {
+		_, err := New(WithProxy(proxyURL))
+		assert.Error(test, err, proxyURL)
+	}
+}
+
+func TestProxyDialer_refused(test *testing.T) {
+	ctx := context.TODO()
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(test, err)
+	defer listener.Close()
+	go func() {
+		conn, err := listener.Accept()
+		if err == nil {
+			http.ReadRequest(bufio.NewReader(conn))
+			io.WriteString(conn, "HTTP/1.1 407 Proxy Authentication Required\r\n\r\n")
+			conn.Close()
+		}
+	}()
+	testObject := &SdkAbstractFactoryImpl{}
+	err = WithProxy("http://" + listener.Addr().String())(testObject)
+	testError(test, ctx, err)
+	_, err = proxyDialer(testObject.GrpcProxy)(ctx, "senzing.internal:8258")
+	assert.ErrorContains(test, err, "407")
+}
@@ -0,0 +1,151 @@
+package factory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/senzing/g2-sdk-go/g2api"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// AddRecordResult is the typed form of the "withInfo" JSON returned by G2engine.AddRecordWithInfo.
+type AddRecordResult struct {
+	AffectedEntities    []int64             // Identifiers of the entities the record changed.
+	DataSource          string              // Data source code of the added record.
+	InterestingEntities []InterestingEntity // Entities Senzing flags as interesting.
+	Raw                 string              // The unparsed withInfo JSON.
+	RecordID            string              // Identifier of the added record.
+}
+
+// InterestingEntity is an entry of INTERESTING_ENTITIES in the withInfo JSON.
+type InterestingEntity struct {
+	Degrees  int      // Degrees of separation from the added record.
+	EntityID int64    // Identifier of the interesting entity.
+	Flags    []string // Reasons the entity is interesting.
+}
+
+// withInfoJson mirrors the withInfo JSON returned by G2engine.AddRecordWithInfo.
+type withInfoJson struct {
+	AffectedEntities []struct {
+		EntityID int64 `json:"ENTITY_ID"`
+	} `json:"AFFECTED_ENTITIES"`
+	DataSource          string `json:"DATA_SOURCE"`
+	InterestingEntities struct {
+		Entities []struct {
+			Degrees  int      `json:"DEGREES"`
+			EntityID int64    `json:"ENTITY_ID"`
+			Flags    []string `json:"FLAGS"`
+		} `json:"ENTITIES"`
+	} `json:"INTERESTING_ENTITIES"`
+	RecordID string `json:"RECORD_ID"`
+}
+
+// ----------------------------------------------------------------------------
+// Functions
+// ----------------------------------------------------------------------------
+
+/*
+The AddRecord function adds a record to g2engine with G2engine.AddRecordWithInfo and parses the withInfo JSON.
+With FlagsWithoutInfo, which takes precedence over FlagsWithInfo, it uses G2engine.AddRecord instead,
+and the result only identifies the record.
+RecordProcessor implementations use it for their AddRecord method.
+
+Input
+  - ctx: A context to control lifecycle.
+  - g2engine: The G2engine the record is added to.
+  - dataSource: The data source code of the record.
+  - recordID: The identifier of the record within the data source.
+  - jsonData: The record as a JSON document.
+  - flags: The engine flags passed to AddRecordWithInfo, or FlagsWithoutInfo.
+
+Output
+  - The parsed withInfo result, or only the record's identifiers with FlagsWithoutInfo.
+*/
+func AddRecord(ctx context.Context, g2engine g2api.G2engine, dataSource string, recordID string, jsonData string, flags Flags) (*AddRecordResult, error) {
+	if flags.Has(FlagsWithoutInfo) {
+		if err := g2engine.AddRecord(ctx, dataSource, recordID, jsonData, ""); err != nil {
+			return nil, err
+		}
+		result := &AddRecordResult{
+			AffectedEntities:    []int64{},
+			DataSource:          dataSource,
+			InterestingEntities: []InterestingEntity{},
+			RecordID:            recordID,
+		}
+		return result, nil
+	}
+	withInfo, err := g2engine.AddRecordWithInfo(ctx, dataSource, recordID, jsonData, "", flags.Int64())
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddRecordResult(withInfo)
+}
+
+/*
+The ParseAddRecordResult function parses the withInfo JSON returned by G2engine.AddRecordWithInfo.
+
+Input
+  - withInfo: The JSON document; an empty string, as from the null backend, yields an empty result.
+
+Output
+  - The parsed result, with Raw set to withInfo.
+*/
+func ParseAddRecordResult(withInfo string) (*AddRecordResult, error) {
+	result := &AddRecordResult{
+		AffectedEntities:    []int64{},
+		InterestingEntities: []InterestingEntity{},
+		Raw:                 withInfo,
+	}
+	if len(withInfo) == 0 {
+		return result, nil
+	}
+	parsed := withInfoJson{}
+	err := json.Unmarshal([]byte(withInfo), &parsed)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse withInfo: %w", err)
+	}
+	result.DataSource = parsed.DataSource
+	result.RecordID = parsed.RecordID
+	for _, affectedEntity := range parsed.AffectedEntities {
+		result.AffectedEntities = append(result.AffectedEntities, affectedEntity.EntityID)
+	}
+	for _, entity := range parsed.InterestingEntities.Entities {
+		result.InterestingEntities = append(result.InterestingEntities, InterestingEntity{
+			Degrees:  entity.Degrees,
+			EntityID: entity.EntityID,
+			Flags:    entity.Flags,
+		})
+	}
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Interface methods
+// ----------------------------------------------------------------------------
+
+/*
+The AddRecord method adds a record to the factory's G2engine, as described for the AddRecord function.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSource: The data source code of the record.
+  - recordID: The identifier of the record within the data source.
+  - jsonData: The record as a JSON document.
+  - flags: The engine flags passed to AddRecordWithInfo, or FlagsWithoutInfo;
+    FlagsDefault selects the flags set by WithDefaultEngineFlags.
+
+Output
+  - The parsed withInfo result, or only the record's identifiers with FlagsWithoutInfo.
+*/
+func (factory *SdkAbstractFactoryImpl) AddRecord(ctx context.Context, dataSource string, recordID string, jsonData string, flags Flags) (*AddRecordResult, error) {
+	ctx = factory.getContext(ctx)
+	g2engine, err := factory.GetG2engine(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return AddRecord(ctx, g2engine, dataSource, recordID, jsonData, factory.engineFlags(flags))
+}
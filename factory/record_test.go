@@ -0,0 +1,117 @@
+package factory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/senzing/g2-sdk-go/g2api"
+	"github.com/stretchr/testify/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Test doubles
+// ----------------------------------------------------------------------------
+
+// addRecordG2engine returns withInfo from AddRecordWithInfo and records the calls and flags it was given.
+type addRecordG2engine struct {
+	g2api.G2engine
+	calls    []string
+	flags    int64
+	withInfo string
+}
+
+func (g2engine *addRecordG2engine) AddRecord(ctx context.Context, dataSourceCode string, recordID string, jsonData string, loadID string) error {
+	g2engine.calls = append(g2engine.calls, "AddRecord")
+	return nil
+}
+
+func (g2engine *addRecordG2engine) AddRecordWithInfo(ctx context.Context, dataSourceCode string, recordID string, jsonData string, loadID string, flags int64) (string, error) {
+	g2engine.calls = append(g2engine.calls, "AddRecordWithInfo")
+	g2engine.flags = flags
+	return g2engine.withInfo, nil
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const testWithInfo = `{"DATA_SOURCE": "CUSTOMERS", "RECORD_ID": "1001", "AFFECTED_ENTITIES": [{"ENTITY_ID": 1}, {"ENTITY_ID": 100001}], "INTERESTING_ENTITIES": {"ENTITIES": [{"ENTITY_ID": 200001, "DEGREES": 1, "FLAGS": ["WATCHLIST_MATCH"], "SAMPLE_RECORDS": [{"DATA_SOURCE": "WATCHLIST", "RECORD_ID": "1007", "FLAGS": ["WATCHLIST_MATCH"]}]}]}}`
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSdkAbstractFactoryImpl_AddRecord(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &addRecordG2engine{withInfo: testWithInfo}
+	testObject := getTestObjectWithG2engine(g2engine)
+	actual, err := testObject.AddRecord(ctx, "CUSTOMERS", "1001", `{"NAME_FULL": "Robert Smith"}`, FlagsWithInfo)
+	testError(test, ctx, err)
+	expected := &AddRecordResult{
+		AffectedEntities: []int64{1, 100001},
+		DataSource:       "CUSTOMERS",
+		InterestingEntities: []InterestingEntity{
+			{Degrees: 1, EntityID: 200001, Flags: []string{"WATCHLIST_MATCH"}},
+		},
+		Raw:      testWithInfo,
+		RecordID: "1001",
+	}
+	assert.Equal(test, expected, actual)
+	assert.Equal(test, []string{"AddRecordWithInfo"}, g2engine.calls)
+	assert.Equal(test, int64(0), g2engine.flags, "FlagsWithInfo must not be passed to the engine")
+}
+
+func TestSdkAbstractFactoryImpl_AddRecord_withInfoByDefault(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &addRecordG2engine{withInfo: testWithInfo}
+	testObject := getTestObjectWithG2engine(g2engine)
+	actual, err := testObject.AddRecord(ctx, "CUSTOMERS", "1001", `{"NAME_FULL": "Robert Smith"}`, FlagsNone)
+	testError(test, ctx, err)
+	assert.Equal(test, testWithInfo, actual.Raw)
+	assert.Equal(test, []int64{1, 100001}, actual.AffectedEntities)
+	assert.Equal(test, []string{"AddRecordWithInfo"}, g2engine.calls)
+}
+
+func TestSdkAbstractFactoryImpl_AddRecord_withoutInfo(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &addRecordG2engine{withInfo: testWithInfo}
+	testObject := getTestObjectWithG2engine(g2engine)
+	actual, err := testObject.AddRecord(ctx, "CUSTOMERS", "1001", `{"NAME_FULL": "Robert Smith"}`, FlagsWithoutInfo)
+	testError(test, ctx, err)
+	expected := &AddRecordResult{
+		AffectedEntities:    []int64{},
+		DataSource:          "CUSTOMERS",
+		InterestingEntities: []InterestingEntity{},
+		RecordID:            "1001",
+	}
+	assert.Equal(test, expected, actual)
+	assert.Equal(test, []string{"AddRecord"}, g2engine.calls)
+}
+
+func TestSdkAbstractFactoryImpl_AddRecord_malformed(test *testing.T) {
+	ctx := context.TODO()
+	testObject := getTestObjectWithG2engine(&addRecordG2engine{withInfo: `{"AFFECTED_ENTITIES": {}}`})
+	_, err := testObject.AddRecord(ctx, "CUSTOMERS", "1001", `{"NAME_FULL": "Robert Smith"}`, FlagsWithInfo)
+	assert.ErrorContains(test, err, "cannot parse withInfo")
+}
+
+func TestParseAddRecordResult_empty(test *testing.T) {
+	ctx := context.TODO()
+	actual, err := ParseAddRecordResult("")
+	testError(test, ctx, err)
+	assert.Empty(test, actual.AffectedEntities)
+	assert.Empty(test, actual.InterestingEntities)
+}
+
+func TestSdkAbstractFactoryImpl_AddRecord_defaultEngineFlags(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &addRecordG2engine{withInfo: testWithInfo}
+	testObject := getTestObjectWithG2engine(g2engine)
+	testObject.DefaultEngineFlags = Combine(FlagsWithInfo, Flags(1<<40))
+	_, err := testObject.AddRecord(ctx, "CUSTOMERS", "1001", `{"NAME_FULL": "Robert Smith"}`, FlagsDefault)
+	testError(test, ctx, err)
+	assert.Equal(test, int64(1<<40), g2engine.flags)
+	_, err = testObject.AddRecord(ctx, "CUSTOMERS", "1001", `{"NAME_FULL": "Robert Smith"}`, FlagsWithInfo)
+	testError(test, ctx, err)
+	assert.Equal(test, int64(0), g2engine.flags, "flags without FlagsDefault must not select the default")
+}
@@ -0,0 +1,84 @@
+package factory
+
+import (
+	"context"
+
+	"github.com/senzing/g2-sdk-go/g2api"
+)
+
+// ----------------------------------------------------------------------------
+// Functions
+// ----------------------------------------------------------------------------
+
+/*
+The ProcessRedoRecords function drains the redo queue of g2engine: it takes records with
+G2engine.GetRedoRecord and processes each with G2engine.ProcessWithInfo, or G2engine.Process
+without withInfo, until the queue is empty or maxRecords records have been processed.
+Cancelling ctx stops the loop before the next record is taken.
+RecordProcessor implementations use it for their ProcessRedoRecords method.
+
+Input
+  - ctx: A context to control lifecycle.
+  - g2engine: The G2engine whose redo queue is processed.
+  - maxRecords: The most records to process; 0 or less processes until the queue is empty.
+  - withInfo: Whether to use ProcessWithInfo and collect its withInfo JSON.
+  - flags: Engine flags passed to ProcessWithInfo.
+
+Output
+  - The number of records processed, including before an error.
+  - The withInfo JSON of each processed record, in order; nil without withInfo.
+*/
+func ProcessRedoRecords(ctx context.Context, g2engine g2api.G2engine, maxRecords int, withInfo bool, flags Flags) (int, []string, error) {
+	processed := 0
+	var infos []string
+	for maxRecords <= 0 || processed < maxRecords {
+		if err := ctx.Err(); err != nil {
+			return processed, infos, err
+		}
+		redoRecord, err := g2engine.GetRedoRecord(ctx)
+		if err != nil {
+			return processed, infos, err
+		}
+		if len(redoRecord) == 0 {
+			break
+		}
+		if withInfo {
+			info, err := g2engine.ProcessWithInfo(ctx, redoRecord, flags.Int64())
+			if err != nil {
+				return processed, infos, err
+			}
+			infos = append(infos, info)
+		} else if err := g2engine.Process(ctx, redoRecord); err != nil {
+			return processed, infos, err
+		}
+		processed++
+	}
+	return processed, infos, nil
+}
+
+// ----------------------------------------------------------------------------
+// Interface methods
+// ----------------------------------------------------------------------------
+
+/*
+The ProcessRedoRecords method drains the redo queue of the factory's G2engine,
+as described for the ProcessRedoRecords function.  ProcessWithInfo is passed no engine flags:
+the flags set by WithDefaultEngineFlags select what is exported, which does not apply to redo records.
+
+Input
+  - ctx: A context to control lifecycle.
+  - maxRecords: The most records to process; 0 or less processes until the queue is empty.
+  - withInfo: Whether to use ProcessWithInfo and collect its withInfo JSON.
+
+Output
+  - The number of records processed, including before an error.
+  - The withInfo JSON of each processed record, in order; nil without withInfo.
+*/
+func (factory *SdkAbstractFactoryImpl) ProcessRedoRecords(ctx context.Context, maxRecords int, withInfo bool) (int, []string, error) {
+	ctx = factory.getContext(ctx)
+	g2engine, err := factory.GetG2engine(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+	return ProcessRedoRecords(ctx, g2engine, maxRecords, withInfo, FlagsNone)
+}
@@ -0,0 +1,94 @@
+package factory
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/senzing/g2-sdk-go/g2api"
+	"github.com/stretchr/testify/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Test doubles
+// ----------------------------------------------------------------------------
+
+// redoG2engine yields redoRecords from GetRedoRecord, then an empty queue.
+type redoG2engine struct {
+	g2api.G2engine
+	cancel      context.CancelFunc
+	flags       []int64
+	processed   []string
+	redoRecords []string
+}
+
+func (g2engine *redoG2engine) GetRedoRecord(ctx context.Context) (string, error) {
+	if len(g2engine.redoRecords) == 0 {
+		return "", nil
+	}
+	result := g2engine.redoRecords[0]
+	g2engine.redoRecords = g2engine.redoRecords[1:]
+	return result, nil
+}
+
+func (g2engine *redoG2engine) Process(ctx context.Context, record string) error {
+	g2engine.processed = append(g2engine.processed, record)
+	if g2engine.cancel != nil {
+		g2engine.cancel()
+	}
+	return nil
+}
+
+func (g2engine *redoG2engine) ProcessWithInfo(ctx context.Context, record string, flags int64) (string, error) {
+	g2engine.processed = append(g2engine.processed, record)
+	g2engine.flags = append(g2engine.flags, flags)
+	return fmt.Sprintf(`{"REDO": %s}`, record), nil
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSdkAbstractFactoryImpl_ProcessRedoRecords(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &redoG2engine{redoRecords: []string{`{"ID": 1}`, `{"ID": 2}`, `{"ID": 3}`}}
+	testObject := getTestObjectWithG2engine(g2engine)
+	testObject.DefaultEngineFlags = FlagsExportIncludeAllEntities
+	processed, infos, err := testObject.ProcessRedoRecords(ctx, 0, true)
+	testError(test, ctx, err)
+	assert.Equal(test, 3, processed)
+	assert.Equal(test, []string{`{"REDO": {"ID": 1}}`, `{"REDO": {"ID": 2}}`, `{"REDO": {"ID": 3}}`}, infos)
+	assert.Equal(test, []int64{0, 0, 0}, g2engine.flags, "the export flags must not be used for redo records")
+}
+
+func TestSdkAbstractFactoryImpl_ProcessRedoRecords_maxRecords(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &redoG2engine{redoRecords: []string{`{"ID": 1}`, `{"ID": 2}`, `{"ID": 3}`}}
+	testObject := getTestObjectWithG2engine(g2engine)
+	processed, infos, err := testObject.ProcessRedoRecords(ctx, 2, false)
+	testError(test, ctx, err)
+	assert.Equal(test, 2, processed)
+	assert.Nil(test, infos)
+	assert.Equal(test, []string{`{"ID": 1}`, `{"ID": 2}`}, g2engine.processed)
+	assert.Len(test, g2engine.redoRecords, 1)
+}
+
+func TestSdkAbstractFactoryImpl_ProcessRedoRecords_cancelled(test *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+	g2engine := &redoG2engine{cancel: cancel, redoRecords: []string{`{"ID": 1}`, `{"ID": 2}`, `{"ID": 3}`}}
+	testObject := getTestObjectWithG2engine(g2engine)
+	processed, _, err := testObject.ProcessRedoRecords(ctx, 0, false)
+	assert.ErrorIs(test, err, context.Canceled)
+	assert.Equal(test, 1, processed)
+	assert.Len(test, g2engine.redoRecords, 2)
+}
+
+func TestSdkAbstractFactoryImpl_ProcessRedoRecords_null(test *testing.T) {
+	ctx := context.TODO()
+	testObject := &SdkAbstractFactoryImpl{NullBackend: true}
+	processed, infos, err := testObject.ProcessRedoRecords(ctx, 0, true)
+	testError(test, ctx, err)
+	assert.Zero(test, processed)
+	assert.Nil(test, infos)
+}
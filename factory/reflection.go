@@ -0,0 +1,98 @@
+package factory
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// SenzingGrpcServices lists the fully-qualified names of the services a Senzing gRPC server
+// registers, in the order VerifyGrpcServices reports them.
+var SenzingGrpcServices = []string{
+	"g2.G2Config",
+	"g2.G2ConfigMgr",
+	"g2.G2Diagnostic",
+	"g2.G2Engine",
+	"g2.G2Product",
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// List the services registered on the server, as reported by gRPC server reflection.
+func listGrpcServices(ctx context.Context, grpcConnection *grpc.ClientConn) (map[string]bool, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := reflectionpb.NewServerReflectionClient(grpcConnection).ServerReflectionInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	request := &reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+	}
+	if err := stream.Send(request); err != nil {
+		return nil, err
+	}
+	response, err := stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	if errorResponse := response.GetErrorResponse(); errorResponse != nil {
+		return nil, fmt.Errorf("server reflection: %s", errorResponse.GetErrorMessage())
+	}
+	result := map[string]bool{}
+	for _, service := range response.GetListServicesResponse().GetService() {
+		result[service.GetName()] = true
+	}
+	return result, stream.CloseSend()
+}
+
+// ----------------------------------------------------------------------------
+// Interface methods
+// ----------------------------------------------------------------------------
+
+/*
+The VerifyGrpcServices method asks the gRPC server, through server reflection,
+which services it registers, and checks that every one of SenzingGrpcServices is among them.
+It is meant for diagnosing deployments, e.g. a GrpcAddress that points at the wrong server.
+The server must have reflection enabled.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - An error wrapping ErrMissingGrpcServices that lists the missing services,
+    or ErrUnsupportedMode unless the factory is in ModeGrpc.
+*/
+func (factory *SdkAbstractFactoryImpl) VerifyGrpcServices(ctx context.Context) error {
+	ctx = factory.getContext(ctx)
+	if mode := factory.Mode(); mode != ModeGrpc {
+		return fmt.Errorf("%w: VerifyGrpcServices requires a gRPC factory, not %s", ErrUnsupportedMode, mode)
+	}
+	grpcConnection, err := factory.getGrpcConnection(ctx)
+	if err != nil {
+		return err
+	}
+	registered, err := listGrpcServices(ctx, grpcConnection)
+	if err != nil {
+		return fmt.Errorf("cannot list the services of %s: %w", grpcConnection.Target(), err)
+	}
+	missing := []string{}
+	for _, service := range SenzingGrpcServices {
+		if !registered[service] {
+			missing = append(missing, service)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: %s", ErrMissingGrpcServices, strings.Join(missing, ", "))
+	}
+	return nil
+}
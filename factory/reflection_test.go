@@ -0,0 +1,75 @@
+package factory
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Start an in-process gRPC server with reflection that registers the named, method-less services.
+func startReflectionTestGrpcServer(test *testing.T, serviceNames ...string) string {
+	listener, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		test.Fatal(err)
+	}
+	server := grpc.NewServer()
+	for _, serviceName := range serviceNames {
+		server.RegisterService(&grpc.ServiceDesc{
+			ServiceName: serviceName,
+			HandlerType: (*interface{})(nil),
+		}, struct{}{})
+	}
+	reflection.Register(server)
+	go server.Serve(listener)
+	test.Cleanup(server.Stop)
+	return listener.Addr().String()
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSdkAbstractFactoryImpl_VerifyGrpcServices(test *testing.T) {
+	ctx := context.TODO()
+	grpcAddress := startReflectionTestGrpcServer(test, SenzingGrpcServices...)
+	testObject := &SdkAbstractFactoryImpl{GrpcAddress: grpcAddress}
+	defer testObject.Destroy(ctx)
+	assert.NoError(test, testObject.VerifyGrpcServices(ctx))
+}
+
+func TestSdkAbstractFactoryImpl_VerifyGrpcServices_missing(test *testing.T) {
+	ctx := context.TODO()
+	grpcAddress := startReflectionTestGrpcServer(test, "g2.G2Engine", "g2.G2Product")
+	testObject := &SdkAbstractFactoryImpl{GrpcAddress: grpcAddress}
+	defer testObject.Destroy(ctx)
+	err := testObject.VerifyGrpcServices(ctx)
+	assert.ErrorIs(test, err, ErrMissingGrpcServices)
+	assert.ErrorContains(test, err, "g2.G2Config, g2.G2ConfigMgr, g2.G2Diagnostic")
+	assert.NotContains(test, err.Error(), "g2.G2Engine")
+	assert.NotContains(test, err.Error(), "g2.G2Product")
+}
+
+func TestSdkAbstractFactoryImpl_VerifyGrpcServices_noReflection(test *testing.T) {
+	ctx := context.TODO()
+	testObject := &SdkAbstractFactoryImpl{GrpcAddress: startTestGrpcServer(test)}
+	defer testObject.Destroy(ctx)
+	err := testObject.VerifyGrpcServices(ctx)
+	assert.Error(test, err)
+	assert.NotErrorIs(test, err, ErrMissingGrpcServices)
+}
+
+func TestSdkAbstractFactoryImpl_VerifyGrpcServices_notGrpc(test *testing.T) {
+	ctx := context.TODO()
+	testObject := &SdkAbstractFactoryImpl{}
+	assert.ErrorIs(test, testObject.VerifyGrpcServices(ctx), ErrUnsupportedMode)
+	testObject = &SdkAbstractFactoryImpl{NullBackend: true}
+	assert.ErrorIs(test, testObject.VerifyGrpcServices(ctx), ErrUnsupportedMode)
+}
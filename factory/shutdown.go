@@ -0,0 +1,81 @@
+package factory
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// inFlightClientStream stops counting a stream as in flight once RecvMsg fails.
+type inFlightClientStream struct {
+	grpc.ClientStream
+	done          atomic.Bool
+	inFlightCalls *atomic.Int64
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// How often Destroy checks whether the in-flight gRPC calls have finished.
+const gracefulShutdownPollInterval = 10 * time.Millisecond
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Stop counting the stream as in flight once RecvMsg fails.
+func (clientStream *inFlightClientStream) RecvMsg(message interface{}) error {
+	err := clientStream.ClientStream.RecvMsg(message)
+	if err != nil && clientStream.done.CompareAndSwap(false, true) {
+		clientStream.inFlightCalls.Add(-1)
+	}
+	return err
+}
+
+// Unary interceptor that counts the calls in flight for a graceful shutdown.
+func (factory *SdkAbstractFactoryImpl) inFlightUnaryInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		factory.grpcInFlightCalls.Add(1)
+		defer factory.grpcInFlightCalls.Add(-1)
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// Stream interceptor that counts the streams in flight for a graceful shutdown.
+// A stream is in flight until RecvMsg fails, which includes receiving io.EOF at its end.
+func (factory *SdkAbstractFactoryImpl) inFlightStreamInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		clientStream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			return nil, err
+		}
+		factory.grpcInFlightCalls.Add(1)
+		return &inFlightClientStream{ClientStream: clientStream, inFlightCalls: &factory.grpcInFlightCalls}, nil
+	}
+}
+
+// Wait, up to GracefulShutdownTimeout, for the in-flight gRPC calls to finish, logging how many
+// were in flight when waiting started and, after a timeout, how many are abandoned.
+func (factory *SdkAbstractFactoryImpl) waitForGrpcCalls() {
+	inFlightCalls := factory.grpcInFlightCalls.Load()
+	if factory.GracefulShutdownTimeout <= 0 || inFlightCalls == 0 {
+		return
+	}
+	factory.getLogger().Log(2004, factory.GracefulShutdownTimeout, inFlightCalls)
+	clock := factory.getClock()
+	deadline := clock.Now().Add(factory.GracefulShutdownTimeout)
+	for inFlightCalls > 0 && clock.Now().Before(deadline) {
+		<-clock.After(gracefulShutdownPollInterval)
+		inFlightCalls = factory.grpcInFlightCalls.Load()
+	}
+	if inFlightCalls > 0 {
+		factory.getLogger().Log(3002, inFlightCalls)
+	}
+}
@@ -0,0 +1,77 @@
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Start a gRPC server exposing the health service whose calls take delay, signalling started as each begins.
+func startSlowTestGrpcServer(test *testing.T, delay time.Duration, started chan<- struct{}) string {
+	slow := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		started <- struct{}{}
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		return handler(ctx, req)
+	}
+	return startTestGrpcServer(test, grpc.UnaryInterceptor(slow))
+}
+
+// Start a slow health check on a factory with a graceful shutdown, returning once the server has received it.
+func startInFlightCall(ctx context.Context, test *testing.T, delay time.Duration, timeout time.Duration) (*SdkAbstractFactoryImpl, *recordingLogger, <-chan error) {
+	started := make(chan struct{}, 1)
+	result, err := New(WithGrpcAddress(startSlowTestGrpcServer(test, delay, started)), WithGracefulShutdown(timeout))
+	testError(test, ctx, err)
+	testObject := result.(*SdkAbstractFactoryImpl)
+	logger := &recordingLogger{}
+	testObject.logger = logger
+	grpcConnection, err := testObject.getGrpcConnection(ctx)
+	testError(test, ctx, err)
+	callErr := make(chan error, 1)
+	go func() {
+		_, err := grpc_health_v1.NewHealthClient(grpcConnection).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+		callErr <- err
+	}()
+	<-started
+	return testObject, logger, callErr
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestWithGracefulShutdown(test *testing.T) {
+	ctx := context.TODO()
+	testObject, logger, callErr := startInFlightCall(ctx, test, 200*time.Millisecond, 5*time.Second)
+	err := testObject.Destroy(ctx)
+	testError(test, ctx, err)
+	assert.NoError(test, <-callErr, "Destroy did not wait for the in-flight call")
+	assert.Equal(test, []int{2004}, logger.messageNumbers)
+}
+
+func TestWithGracefulShutdown_timeout(test *testing.T) {
+	ctx := context.TODO()
+	testObject, logger, callErr := startInFlightCall(ctx, test, 10*time.Second, 100*time.Millisecond)
+	start := time.Now()
+	err := testObject.Destroy(ctx)
+	testError(test, ctx, err)
+	assert.Less(test, time.Since(start), 5*time.Second)
+	assert.Error(test, <-callErr, "the call outlived the connection")
+	assert.Equal(test, []int{2004, 3002}, logger.messageNumbers)
+}
+
+func TestWithGracefulShutdown_invalid(test *testing.T) {
+	_, err := New(WithGracefulShutdown(0))
+	assert.Error(test, err)
+}
@@ -0,0 +1,117 @@
+package factory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// EngineStats is the typed form of the workload statistics in the JSON returned by G2engine.Stats.
+type EngineStats struct {
+	AddedRecords     int64  // Records added since the last call to Stats.
+	APIVersion       string // Version of the Senzing API reporting the statistics.
+	Candidates       int64  // Candidate entities examined during resolution.
+	DeletedRecords   int64  // Records deleted since the last call to Stats.
+	Duration         int64  // Length of the measured period, as reported by Senzing.
+	LoadedRecords    int64  // Records loaded since the last call to Stats.
+	Reevaluations    int64  // Entities re-evaluated.
+	RepairedEntities int64  // Entities repaired.
+	Retries          int64  // Operations retried.
+}
+
+// engineStatsJson mirrors the part of the JSON document returned by G2engine.Stats that is parsed.
+type engineStatsJson struct {
+	Workload struct {
+		AddedRecords     int64  `json:"addedRecords"`
+		APIVersion       string `json:"apiVersion"`
+		Candidates       int64  `json:"candidates"`
+		DeletedRecords   int64  `json:"deletedRecords"`
+		Duration         int64  `json:"duration"`
+		LoadedRecords    int64  `json:"loadedRecords"`
+		Reevaluations    int64  `json:"reevaluations"`
+		RepairedEntities int64  `json:"repairedEntities"`
+		Retries          int64  `json:"retries"`
+	} `json:"workload"`
+}
+
+// ----------------------------------------------------------------------------
+// Functions
+// ----------------------------------------------------------------------------
+
+/*
+The ParseEngineStats function parses the JSON returned by G2engine.Stats.
+Unknown fields are ignored.
+
+Input
+  - stats: The JSON document; an empty string, as from the null backend, yields zero statistics.
+
+Output
+  - The parsed statistics.
+*/
+func ParseEngineStats(stats string) (EngineStats, error) {
+	if len(stats) == 0 {
+		return EngineStats{}, nil
+	}
+	parsed := engineStatsJson{}
+	err := json.Unmarshal([]byte(stats), &parsed)
+	if err != nil {
+		return EngineStats{}, fmt.Errorf("cannot parse engine stats: %w", err)
+	}
+	result := EngineStats{
+		AddedRecords:     parsed.Workload.AddedRecords,
+		APIVersion:       parsed.Workload.APIVersion,
+		Candidates:       parsed.Workload.Candidates,
+		DeletedRecords:   parsed.Workload.DeletedRecords,
+		Duration:         parsed.Workload.Duration,
+		LoadedRecords:    parsed.Workload.LoadedRecords,
+		Reevaluations:    parsed.Workload.Reevaluations,
+		RepairedEntities: parsed.Workload.RepairedEntities,
+		Retries:          parsed.Workload.Retries,
+	}
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Interface methods
+// ----------------------------------------------------------------------------
+
+/*
+The EngineStats method returns the JSON returned by G2engine.Stats.
+Senzing resets the workload statistics each time they are read.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The statistics as a JSON document.
+*/
+func (factory *SdkAbstractFactoryImpl) EngineStats(ctx context.Context) (string, error) {
+	ctx = factory.getContext(ctx)
+	g2engine, err := factory.GetG2engine(ctx)
+	if err != nil {
+		return "", err
+	}
+	return g2engine.Stats(ctx)
+}
+
+/*
+The EngineStatsParsed method returns the statistics from EngineStats as a typed structure.
+See ParseEngineStats.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The parsed statistics; zero in ModeNull.
+*/
+func (factory *SdkAbstractFactoryImpl) EngineStatsParsed(ctx context.Context) (EngineStats, error) {
+	stats, err := factory.EngineStats(ctx)
+	if err != nil {
+		return EngineStats{}, err
+	}
+	return ParseEngineStats(stats)
+}
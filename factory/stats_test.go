@@ -0,0 +1,75 @@
+package factory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/senzing/g2-sdk-go/g2api"
+	"github.com/stretchr/testify/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Test doubles
+// ----------------------------------------------------------------------------
+
+type statsG2engine struct {
+	g2api.G2engine
+	stats string
+}
+
+func (g2engine *statsG2engine) Stats(ctx context.Context) (string, error) {
+	return g2engine.stats, nil
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const testEngineStats = `{"workload": {"apiVersion": "3.5.0.23041", "loadedRecords": 7, "addedRecords": 5, "deletedRecords": 1, "reevaluations": 2, "repairedEntities": 1, "duration": 12, "retries": 0, "candidates": 19, "actualAmbiguousTest": 0, "cachedAmbiguousTest": 0, "libFeatCacheHit": 214, "libFeatCacheMiss": 37, "unresolveTest": 1, "abortedUnresolve": 0, "lockWaits": {"refreshLocks": {"maxMS": 0, "totalMS": 0, "count": 0}}, "systemResources": {"initResources": [{"physicalCores": 8}, {"logicalCores": 16}]}}}`
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSdkAbstractFactoryImpl_EngineStats(test *testing.T) {
+	ctx := context.TODO()
+	testObject := getTestObjectWithG2engine(&statsG2engine{stats: testEngineStats})
+	actual, err := testObject.EngineStats(ctx)
+	testError(test, ctx, err)
+	assert.Equal(test, testEngineStats, actual)
+}
+
+func TestSdkAbstractFactoryImpl_EngineStatsParsed(test *testing.T) {
+	ctx := context.TODO()
+	testObject := getTestObjectWithG2engine(&statsG2engine{stats: testEngineStats})
+	actual, err := testObject.EngineStatsParsed(ctx)
+	testError(test, ctx, err)
+	expected := EngineStats{
+		AddedRecords:     5,
+		APIVersion:       "3.5.0.23041",
+		Candidates:       19,
+		DeletedRecords:   1,
+		Duration:         12,
+		LoadedRecords:    7,
+		Reevaluations:    2,
+		RepairedEntities: 1,
+		Retries:          0,
+	}
+	assert.Equal(test, expected, actual)
+}
+
+func TestSdkAbstractFactoryImpl_EngineStatsParsed_malformed(test *testing.T) {
+	ctx := context.TODO()
+	testObject := getTestObjectWithG2engine(&statsG2engine{stats: `{"workload": [`})
+	_, err := testObject.EngineStatsParsed(ctx)
+	assert.ErrorContains(test, err, "cannot parse engine stats")
+}
+
+func TestSdkAbstractFactoryImpl_EngineStatsParsed_null(test *testing.T) {
+	ctx := context.TODO()
+	testObject, err := New(WithNullBackend())
+	testError(test, ctx, err)
+	actual, err := testObject.EngineStatsParsed(ctx)
+	testError(test, ctx, err)
+	assert.Zero(test, actual)
+}
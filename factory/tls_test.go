@@ -0,0 +1,177 @@
+package factory
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Create a self-signed certificate for localhost, writing the PEM-encoded certificate
+// and key to a temporary directory.
+func createSelfSignedCertificate(test *testing.T) (tls.Certificate, string, string) {
+	return createSelfSignedCertificateFor(test, "localhost", []net.IP{net.ParseIP("127.0.0.1")})
+}
+
+// Create a self-signed certificate for dnsName and ipAddresses, writing the PEM-encoded
+// certificate and key to a temporary directory.
+func createSelfSignedCertificateFor(test *testing.T, dnsName string, ipAddresses []net.IP) (tls.Certificate, string, string) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(test, err)
+	template := &x509.Certificate{
+		BasicConstraintsValid: true,
+		DNSNames:              []string{dnsName},
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:           ipAddresses,
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		NotAfter:              time.Now().Add(time.Hour),
+		NotBefore:             time.Now().Add(-time.Minute),
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: dnsName},
+	}
+	certificateDer, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	require.NoError(test, err)
+	privateKeyDer, err := x509.MarshalECPrivateKey(privateKey)
+	require.NoError(test, err)
+	certificatePem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificateDer})
+	privateKeyPem := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: privateKeyDer})
+
+	directory := test.TempDir()
+	certFile := filepath.Join(directory, "cert.pem")
+	keyFile := filepath.Join(directory, "key.pem")
+	require.NoError(test, os.WriteFile(certFile, certificatePem, 0600))
+	require.NoError(test, os.WriteFile(keyFile, privateKeyPem, 0600))
+	certificate, err := tls.X509KeyPair(certificatePem, privateKeyPem)
+	require.NoError(test, err)
+	return certificate, certFile, keyFile
+}
+
+// Start an in-process gRPC server exposing the health service and return its address.
+func startTestGrpcServer(test *testing.T, serverOptions ...grpc.ServerOption) string {
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(test, err)
+	server := grpc.NewServer(serverOptions...)
+	grpc_health_v1.RegisterHealthServer(server, health.NewServer())
+	go server.Serve(listener)
+	test.Cleanup(server.Stop)
+	return listener.Addr().String()
+}
+
+// Make a health check round trip over the factory's gRPC connection.
+func checkTestGrpcConnection(ctx context.Context, factory ModeManager) error {
+	grpcConnection, err := factory.GrpcConnection(ctx)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	_, err = grpc_health_v1.NewHealthClient(grpcConnection).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	return err
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestWithTLSFromFile(test *testing.T) {
+	ctx := context.TODO()
+	certificate, certFile, _ := createSelfSignedCertificate(test)
+	grpcAddress := startTestGrpcServer(test, grpc.Creds(credentials.NewServerTLSFromCert(&certificate)))
+	testObject, err := New(WithGrpcAddress(grpcAddress), WithTLSFromFile(certFile))
+	testError(test, ctx, err)
+	defer testObject.Destroy(ctx)
+	err = checkTestGrpcConnection(ctx, testObject)
+	testError(test, ctx, err)
+}
+
+func TestWithTLSFromFile_missingFile(test *testing.T) {
+	_, err := New(WithTLSFromFile(filepath.Join(test.TempDir(), "missing.pem")))
+	assert.Error(test, err)
+}
+
+func TestWithTransportCredentials_noInsecureFallback(test *testing.T) {
+	ctx := context.TODO()
+	_, certFile, _ := createSelfSignedCertificate(test)
+	transportCredentials, err := credentials.NewClientTLSFromFile(certFile, "")
+	testError(test, ctx, err)
+	grpcAddress := startTestGrpcServer(test)
+	testObject, err := New(WithGrpcAddress(grpcAddress), WithTransportCredentials(transportCredentials))
+	testError(test, ctx, err)
+	defer testObject.Destroy(ctx)
+	err = checkTestGrpcConnection(ctx, testObject)
+	assert.Error(test, err, "TLS client must not fall back to an insecure connection")
+}
+
+func TestWithMutualTLS(test *testing.T) {
+	ctx := context.TODO()
+	certificate, certFile, keyFile := createSelfSignedCertificate(test)
+	caPem, err := os.ReadFile(certFile)
+	testError(test, ctx, err)
+	caPool := x509.NewCertPool()
+	caPool.AppendCertsFromPEM(caPem)
+	serverCredentials := credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    caPool,
+	})
+	grpcAddress := startTestGrpcServer(test, grpc.Creds(serverCredentials))
+
+	testObject, err := New(WithGrpcAddress(grpcAddress), WithMutualTLS(certFile, keyFile, certFile))
+	testError(test, ctx, err)
+	defer testObject.Destroy(ctx)
+	err = checkTestGrpcConnection(ctx, testObject)
+	testError(test, ctx, err)
+
+	withoutClientCertificate, err := New(WithGrpcAddress(grpcAddress), WithTLSFromFile(certFile))
+	testError(test, ctx, err)
+	defer withoutClientCertificate.Destroy(ctx)
+	err = checkTestGrpcConnection(ctx, withoutClientCertificate)
+	assert.Error(test, err, "server requires a client certificate")
+}
+
+func TestWithMutualTLS_mismatchedKey(test *testing.T) {
+	_, certFile, _ := createSelfSignedCertificate(test)
+	_, _, otherKeyFile := createSelfSignedCertificate(test)
+	_, err := New(WithMutualTLS(certFile, otherKeyFile, certFile))
+	assert.ErrorContains(test, err, "cannot load TLS client key pair")
+}
+
+func TestWithAuthority(test *testing.T) {
+	ctx := context.TODO()
+	certificate, certFile, _ := createSelfSignedCertificateFor(test, "senzing.internal", nil)
+	grpcAddress := startTestGrpcServer(test, grpc.Creds(credentials.NewServerTLSFromCert(&certificate)))
+
+	withoutAuthority, err := New(WithGrpcAddress(grpcAddress), WithTLSFromFile(certFile))
+	testError(test, ctx, err)
+	defer withoutAuthority.Destroy(ctx)
+	err = checkTestGrpcConnection(ctx, withoutAuthority)
+	assert.Error(test, err, "the certificate is not valid for %s", grpcAddress)
+
+	testObject, err := New(WithGrpcAddress(grpcAddress), WithTLSFromFile(certFile), WithAuthority("senzing.internal"))
+	testError(test, ctx, err)
+	defer testObject.Destroy(ctx)
+	err = checkTestGrpcConnection(ctx, testObject)
+	testError(test, ctx, err)
+}
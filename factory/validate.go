@@ -0,0 +1,96 @@
+package factory
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Check one address of GrpcAddress: a gRPC target with a scheme, e.g. "dns:///senzing:8258"
+// or "unix:///var/run/senzing.sock", or a host and port, e.g. "localhost:8258".
+func validateGrpcAddress(address string) error {
+	if strings.HasPrefix(address, "unix:") && !strings.Contains(address, "://") {
+		if len(address) == len("unix:") {
+			return fmt.Errorf("gRPC address %q has no socket path", address)
+		}
+		return nil
+	}
+	if strings.Contains(address, "://") {
+		target, err := url.Parse(address)
+		if err != nil {
+			return fmt.Errorf("gRPC address %q is not a valid target: %w", address, err)
+		}
+		if len(strings.TrimPrefix(target.Path, "/")) == 0 && len(target.Opaque) == 0 {
+			return fmt.Errorf("gRPC address %q has no endpoint", address)
+		}
+		return nil
+	}
+	_, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("gRPC address %q is not host:port: %w", address, err)
+	}
+	portNumber, err := strconv.Atoi(port)
+	if err != nil || portNumber < 1 || portNumber > 65535 {
+		return fmt.Errorf("gRPC address %q has an invalid port %q", address, port)
+	}
+	return nil
+}
+
+// Check that a file can be opened for reading.
+func validateReadableFile(description string, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("cannot read %s: %w", description, err)
+	}
+	return file.Close()
+}
+
+// ----------------------------------------------------------------------------
+// Interface methods
+// ----------------------------------------------------------------------------
+
+/*
+The Validate method checks the factory's configuration as a whole and reports every problem
+at once, rather than one at a time as the GetG2* methods fail.  It creates no objects.
+Besides the checks made by New, such as conflicting settings, including options for local
+initialization on a gRPC factory, and the validity of the engine configuration JSON of a local
+factory, it checks, depending on the mode:
+  - ModeGrpc: every address in GrpcAddress is a gRPC target or host:port, and the files
+    loaded by WithTLSFromFile or WithMutualTLS are still readable.
+  - ModeLocal: ModuleName or ModuleNameTemplate is set and VerboseLogging is 0 or 1.
+
+Output
+  - All problems found, joined with errors.Join, or nil.
+*/
+func (factory *SdkAbstractFactoryImpl) Validate() error {
+	factory.modeMutex.RLock()
+	defer factory.modeMutex.RUnlock()
+	errs := []error{factory.validate()}
+	switch factory.mode() {
+	case ModeGrpc:
+		for _, address := range strings.Split(factory.GrpcAddress, ",") {
+			if address = strings.TrimSpace(address); len(address) > 0 {
+				errs = append(errs, validateGrpcAddress(address))
+			}
+		}
+		for _, tlsFile := range factory.GrpcTLSFiles {
+			errs = append(errs, validateReadableFile("TLS file", tlsFile))
+		}
+	case ModeLocal:
+		if len(factory.ModuleName) == 0 && len(factory.ModuleNameTemplate) == 0 {
+			errs = append(errs, errors.New("module name is empty; use WithModuleName"))
+		}
+		if factory.VerboseLogging != 0 && factory.VerboseLogging != 1 {
+			errs = append(errs, fmt.Errorf("verbose logging must be 0 or 1, not %d", factory.VerboseLogging))
+		}
+	}
+	return errors.Join(errs...)
+}
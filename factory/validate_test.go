@@ -0,0 +1,95 @@
+package factory
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSdkAbstractFactoryImpl_Validate(test *testing.T) {
+	engineConfigurationJson := `{"PIPELINE": {"CONFIGPATH": "/etc/opt/senzing", "RESOURCEPATH": "/opt/senzing/g2/resources", "SUPPORTPATH": "/opt/senzing/data"}, "SQL": {"CONNECTION": "sqlite3://na:na@/tmp/sqlite/G2C.db"}}`
+	_, certFile, _ := createSelfSignedCertificate(test)
+	missingCertFile := filepath.Join(test.TempDir(), "missing.pem")
+	testCases := []struct {
+		name       string
+		testObject *SdkAbstractFactoryImpl
+		expected   []string
+	}{
+		{
+			name:       "local",
+			testObject: &SdkAbstractFactoryImpl{EngineConfigurationJson: engineConfigurationJson, ModuleName: "test", VerboseLogging: 1},
+		},
+		{
+			name:       "grpc",
+			testObject: &SdkAbstractFactoryImpl{GrpcAddress: "localhost:8258,dns:///senzing:8258,unix:///var/run/senzing.sock", GrpcTLSFiles: []string{certFile}},
+		},
+		{
+			name:       "grpcWithModuleName",
+			testObject: &SdkAbstractFactoryImpl{GrpcAddress: "localhost:8258", ModuleName: "test", VerboseLogging: 1},
+		},
+		{
+			name:       "grpcWithLocalBackend",
+			testObject: &SdkAbstractFactoryImpl{EngineConfigurationJson: engineConfigurationJson, GrpcAddress: "localhost:8258", LocalBackend: true, ModuleNameTemplate: "test-{object}", SharedNativeInit: true},
+		},
+		{
+			name:       "null",
+			testObject: &SdkAbstractFactoryImpl{NullBackend: true},
+		},
+		{
+			name:       "localMisconfigured",
+			testObject: &SdkAbstractFactoryImpl{EngineConfigurationJson: `{"PIPELINE": {}}`, VerboseLogging: 7},
+			expected:   []string{"missing PIPELINE.CONFIGPATH", "module name is empty", "verbose logging must be 0 or 1, not 7"},
+		},
+		{
+			name:       "grpcMisconfigured",
+			testObject: &SdkAbstractFactoryImpl{GrpcAddress: "localhost:8258, senzing, localhost:99999", GrpcTLSFiles: []string{certFile, missingCertFile}},
+			expected:   []string{`"senzing" is not host:port`, `"localhost:99999" has an invalid port`, "cannot read TLS file"},
+		},
+		{
+			name: "grpcConflicting",
+			testObject: &SdkAbstractFactoryImpl{
+				EngineConfigurationJson: engineConfigurationJson,
+				GrpcAddress:             "dns:///",
+				GrpcDialer:              func(ctx context.Context) (*grpc.ClientConn, error) { return nil, nil },
+				GrpcSharedConnection:    &grpc.ClientConn{},
+			},
+			expected: []string{"remove WithGrpcDialer or WithGrpcConnection", `"dns:///" has no endpoint`},
+		},
+		{
+			name:       "grpcLocalOnly",
+			testObject: &SdkAbstractFactoryImpl{EngineConfigurationJson: engineConfigurationJson, GrpcAddress: "localhost:8258", ModuleNameTemplate: "test-{object}", SharedNativeInit: true},
+			expected:   []string{"remove WithEngineConfigurationJson, WithModuleNameTemplate, WithSharedNativeInit, or WithGrpcAddress"},
+		},
+	}
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func(test *testing.T) {
+			err := testCase.testObject.Validate()
+			if len(testCase.expected) == 0 {
+				assert.NoError(test, err)
+				return
+			}
+			for _, expected := range testCase.expected {
+				assert.ErrorContains(test, err, expected)
+			}
+			assert.Len(test, err.(interface{ Unwrap() []error }).Unwrap(), len(testCase.expected))
+		})
+	}
+}
+
+func TestSdkAbstractFactoryImpl_Validate_tlsFiles(test *testing.T) {
+	_, certFile, keyFile := createSelfSignedCertificate(test)
+	testObject := &SdkAbstractFactoryImpl{GrpcAddress: "localhost:8258"}
+	assert.NoError(test, WithMutualTLS(certFile, keyFile, certFile)(testObject))
+	assert.Equal(test, []string{certFile, keyFile, certFile}, testObject.GrpcTLSFiles)
+	assert.NoError(test, testObject.Validate())
+	assert.NoError(test, os.Remove(keyFile))
+	assert.ErrorContains(test, testObject.Validate(), keyFile)
+}
@@ -0,0 +1,118 @@
+package factory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// versionJson mirrors the part of the JSON document returned by G2product.Version that is checked.
+type versionJson struct {
+	Version string `json:"VERSION"`
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// MinimumSenzingVersion is the oldest Senzing version supported by the SDKs this package uses.
+const MinimumSenzingVersion = "3.4.0"
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Parse a dotted version such as "3.4.0" into its numeric components.  A prerelease or build
+// suffix, as in "3.8.0-beta" or "3.8.0+23050", is ignored, so it compares equal to its release.
+func parseVersion(version string) ([]int, error) {
+	result := []int{}
+	release := version
+	if index := strings.IndexAny(release, "-+"); index >= 0 {
+		release = release[:index]
+	}
+	for _, component := range strings.Split(release, ".") {
+		number, err := strconv.Atoi(component)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse version %q: %w", version, err)
+		}
+		result = append(result, number)
+	}
+	return result, nil
+}
+
+// Compare dotted versions, returning -1, 0, or 1.  Missing components count as 0, so "3.4" equals "3.4.0".
+func compareVersions(version1 []int, version2 []int) int {
+	for index := 0; index < len(version1) || index < len(version2); index++ {
+		component1, component2 := 0, 0
+		if index < len(version1) {
+			component1 = version1[index]
+		}
+		if index < len(version2) {
+			component2 = version2[index]
+		}
+		if component1 < component2 {
+			return -1
+		}
+		if component1 > component2 {
+			return 1
+		}
+	}
+	return 0
+}
+
+// Check the JSON returned by G2product.Version against MinimumSenzingVersion.
+func checkVersionCompatibility(versionDocument string) error {
+	parsed := versionJson{}
+	err := json.Unmarshal([]byte(versionDocument), &parsed)
+	if err != nil {
+		return fmt.Errorf("cannot parse Senzing version: %w", err)
+	}
+	actual, err := parseVersion(parsed.Version)
+	if err != nil {
+		return err
+	}
+	minimum, err := parseVersion(MinimumSenzingVersion)
+	if err != nil {
+		return err
+	}
+	if compareVersions(actual, minimum) < 0 {
+		return fmt.Errorf("%w: Senzing %s is older than the minimum supported version %s", ErrIncompatibleVersion, parsed.Version, MinimumSenzingVersion)
+	}
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Interface methods
+// ----------------------------------------------------------------------------
+
+/*
+The CheckCompatibility method verifies that the Senzing version reported by G2product.Version,
+locally or by the gRPC server, is at least MinimumSenzingVersion.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - nil if compatible, or in ModeNull; an error wrapping ErrIncompatibleVersion, naming both versions, if not.
+*/
+func (factory *SdkAbstractFactoryImpl) CheckCompatibility(ctx context.Context) error {
+	ctx = factory.getContext(ctx)
+	if factory.NullBackend {
+		return nil
+	}
+	g2product, err := factory.GetG2product(ctx)
+	if err != nil {
+		return err
+	}
+	version, err := g2product.Version(ctx)
+	if err != nil {
+		return err
+	}
+	return checkVersionCompatibility(version)
+}
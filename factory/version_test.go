@@ -0,0 +1,78 @@
+package factory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/senzing/g2-sdk-go/g2api"
+	"github.com/stretchr/testify/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Test doubles
+// ----------------------------------------------------------------------------
+
+type versionG2product struct {
+	g2api.G2product
+	version string
+}
+
+func (g2product *versionG2product) Version(ctx context.Context) (string, error) {
+	return g2product.version, nil
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSdkAbstractFactoryImpl_CheckCompatibility(test *testing.T) {
+	ctx := context.TODO()
+	testObject := getTestObjectWithG2product(&versionG2product{
+		version: `{"PRODUCT_NAME":"Senzing API","VERSION":"3.4.2","BUILD_VERSION":"3.4.2.23050","BUILD_DATE":"2023-02-19","BUILD_NUMBER":"2023_02_19__00_00","COMPATIBILITY_VERSION":{"CONFIG_VERSION":"10"}}`,
+	})
+	err := testObject.CheckCompatibility(ctx)
+	testError(test, ctx, err)
+}
+
+func TestSdkAbstractFactoryImpl_CheckCompatibility_incompatible(test *testing.T) {
+	ctx := context.TODO()
+	testObject := getTestObjectWithG2product(&versionG2product{
+		version: `{"PRODUCT_NAME":"Senzing API","VERSION":"3.3.2","BUILD_VERSION":"3.3.2.22299"}`,
+	})
+	err := testObject.CheckCompatibility(ctx)
+	assert.ErrorIs(test, err, ErrIncompatibleVersion)
+	assert.ErrorContains(test, err, "3.3.2")
+	assert.ErrorContains(test, err, MinimumSenzingVersion)
+}
+
+func TestSdkAbstractFactoryImpl_CheckCompatibility_prerelease(test *testing.T) {
+	ctx := context.TODO()
+	for version, compatible := range map[string]bool{
+		"3.8.0-beta":         true,
+		"3.4.0+23050":        true,
+		"3.3.9-rc.1+build.5": false,
+	} {
+		testObject := getTestObjectWithG2product(&versionG2product{version: `{"VERSION":"` + version + `"}`})
+		err := testObject.CheckCompatibility(ctx)
+		if compatible {
+			assert.NoError(test, err, version)
+		} else {
+			assert.ErrorIs(test, err, ErrIncompatibleVersion, version)
+			assert.ErrorContains(test, err, version)
+		}
+	}
+}
+
+func TestSdkAbstractFactoryImpl_CheckCompatibility_malformed(test *testing.T) {
+	ctx := context.TODO()
+	testObject := getTestObjectWithG2product(&versionG2product{version: `{"VERSION":"three"}`})
+	err := testObject.CheckCompatibility(ctx)
+	assert.Error(test, err)
+	assert.NotErrorIs(test, err, ErrIncompatibleVersion)
+}
+
+func TestCompareVersions(test *testing.T) {
+	assert.Equal(test, 0, compareVersions([]int{3, 4}, []int{3, 4, 0}))
+	assert.Equal(test, -1, compareVersions([]int{3, 3, 9}, []int{3, 4, 0}))
+	assert.Equal(test, 1, compareVersions([]int{3, 10, 0}, []int{3, 4, 0}))
+}
@@ -0,0 +1,614 @@
+/*
+Package factorymock provides an implementation of factory.ManagedSdkAbstractFactory for unit testing
+code that consumes the factory, without a Senzing engine or gRPC server.
+*/
+package factorymock
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/senzing/g2-sdk-go/g2api"
+	"github.com/senzing/go-observing/observer"
+	"github.com/senzing/go-sdk-abstract-factory/factory"
+	"google.golang.org/grpc"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+MockSdkAbstractFactory returns caller-injected Senzing objects from its GetG2* methods.
+When a *Mock field is nil, a built-in stub is returned instead.
+Mode and IsGrpc report ModeMock, which defaults to factory.ModeLocal.
+The stubs' lifecycle, configuration, export and statistics methods return zero values and no error;
+their remaining methods panic, so inject a mock for any method the code under test calls.
+*/
+type MockSdkAbstractFactory struct {
+	G2configMock     g2api.G2config
+	G2configmgrMock  g2api.G2configmgr
+	G2diagnosticMock g2api.G2diagnostic
+	G2engineMock     g2api.G2engine
+	G2productMock    g2api.G2product
+	ModeMock         factory.FactoryMode
+}
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var _ factory.ManagedSdkAbstractFactory = (*MockSdkAbstractFactory)(nil)
+
+// ----------------------------------------------------------------------------
+// Interface methods
+// ----------------------------------------------------------------------------
+
+/*
+The ActiveConfigID method returns GetActiveConfigID of the G2engine from GetG2engine.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The configuration identifier; 0 unless G2engineMock is set.
+*/
+func (mockFactory *MockSdkAbstractFactory) ActiveConfigID(ctx context.Context) (int64, error) {
+	g2engine, err := mockFactory.GetG2engine(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return g2engine.GetActiveConfigID(ctx)
+}
+
+/*
+The AddRecord method adds the record to the G2engine from GetG2engine with factory.AddRecord.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSource: The data source code of the record.
+  - recordID: The identifier of the record within the data source.
+  - jsonData: The record as a JSON document.
+  - flags: The engine flags passed to AddRecordWithInfo, or FlagsWithoutInfo.
+
+Output
+  - The parsed withInfo result; empty unless G2engineMock is set.
+*/
+func (mockFactory *MockSdkAbstractFactory) AddRecord(ctx context.Context, dataSource string, recordID string, jsonData string, flags factory.Flags) (*factory.AddRecordResult, error) {
+	g2engine, err := mockFactory.GetG2engine(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return factory.AddRecord(ctx, g2engine, dataSource, recordID, jsonData, flags)
+}
+
+/*
+The CheckCompatibility method reports no incompatibility.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (mockFactory *MockSdkAbstractFactory) CheckCompatibility(ctx context.Context) error {
+	return nil
+}
+
+/*
+The CreatedObjects method returns the injected mocks.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - A slice of the non-nil *Mock fields.
+*/
+func (mockFactory *MockSdkAbstractFactory) CreatedObjects(ctx context.Context) []interface{} {
+	result := []interface{}{}
+	if mockFactory.G2configMock != nil {
+		result = append(result, mockFactory.G2configMock)
+	}
+	if mockFactory.G2configmgrMock != nil {
+		result = append(result, mockFactory.G2configmgrMock)
+	}
+	if mockFactory.G2diagnosticMock != nil {
+		result = append(result, mockFactory.G2diagnosticMock)
+	}
+	if mockFactory.G2engineMock != nil {
+		result = append(result, mockFactory.G2engineMock)
+	}
+	if mockFactory.G2productMock != nil {
+		result = append(result, mockFactory.G2productMock)
+	}
+	return result
+}
+
+/*
+The DebugInfo method returns debug information listing no objects.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - A DebugInfo in "mock" mode.
+*/
+func (mockFactory *MockSdkAbstractFactory) DebugInfo(ctx context.Context) (factory.DebugInfo, error) {
+	result := factory.DebugInfo{
+		CreatedObjects: []string{},
+		Mode:           "mock",
+	}
+	return result, nil
+}
+
+/*
+The Destroy method does nothing; injected mocks belong to the caller.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (mockFactory *MockSdkAbstractFactory) Destroy(ctx context.Context) error {
+	return nil
+}
+
+/*
+The DestroyG2config method does nothing; injected mocks belong to the caller.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (mockFactory *MockSdkAbstractFactory) DestroyG2config(ctx context.Context) error {
+	return nil
+}
+
+/*
+The DestroyG2configmgr method does nothing; injected mocks belong to the caller.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (mockFactory *MockSdkAbstractFactory) DestroyG2configmgr(ctx context.Context) error {
+	return nil
+}
+
+/*
+The DestroyG2diagnostic method does nothing; injected mocks belong to the caller.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (mockFactory *MockSdkAbstractFactory) DestroyG2diagnostic(ctx context.Context) error {
+	return nil
+}
+
+/*
+The DestroyG2engine method does nothing; injected mocks belong to the caller.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (mockFactory *MockSdkAbstractFactory) DestroyG2engine(ctx context.Context) error {
+	return nil
+}
+
+/*
+The DestroyG2product method does nothing; injected mocks belong to the caller.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (mockFactory *MockSdkAbstractFactory) DestroyG2product(ctx context.Context) error {
+	return nil
+}
+
+/*
+The DiagnosticStats method returns the statistics reported by the G2diagnostic from GetG2diagnostic.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The collected statistics; zero unless G2diagnosticMock is set.
+*/
+func (mockFactory *MockSdkAbstractFactory) DiagnosticStats(ctx context.Context) (factory.DiagnosticStats, error) {
+	result := factory.DiagnosticStats{}
+	g2diagnostic, err := mockFactory.GetG2diagnostic(ctx)
+	if err != nil {
+		return result, err
+	}
+	if result.PhysicalCores, err = g2diagnostic.GetPhysicalCores(ctx); err != nil {
+		return result, err
+	}
+	if result.LogicalCores, err = g2diagnostic.GetLogicalCores(ctx); err != nil {
+		return result, err
+	}
+	if result.TotalSystemMemory, err = g2diagnostic.GetTotalSystemMemory(ctx); err != nil {
+		return result, err
+	}
+	result.AvailableMemory, err = g2diagnostic.GetAvailableMemory(ctx)
+	return result, err
+}
+
+/*
+The EngineStats method returns Stats of the G2engine from GetG2engine.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The statistics as a JSON document; empty unless G2engineMock is set.
+*/
+func (mockFactory *MockSdkAbstractFactory) EngineStats(ctx context.Context) (string, error) {
+	g2engine, err := mockFactory.GetG2engine(ctx)
+	if err != nil {
+		return "", err
+	}
+	return g2engine.Stats(ctx)
+}
+
+/*
+The EngineStatsParsed method returns the statistics from EngineStats, parsed by factory.ParseEngineStats.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The parsed statistics; zero unless G2engineMock is set.
+*/
+func (mockFactory *MockSdkAbstractFactory) EngineStatsParsed(ctx context.Context) (factory.EngineStats, error) {
+	stats, err := mockFactory.EngineStats(ctx)
+	if err != nil {
+		return factory.EngineStats{}, err
+	}
+	return factory.ParseEngineStats(stats)
+}
+
+/*
+The EnsureDefaultConfig method does nothing; the stubs need no configuration.
+
+Input
+  - ctx: A context to control lifecycle.
+  - configComments: Ignored.
+*/
+func (mockFactory *MockSdkAbstractFactory) EnsureDefaultConfig(ctx context.Context, configComments string) error {
+	return nil
+}
+
+/*
+The ExportEntities method returns an empty export.
+
+Input
+  - ctx: A context to control lifecycle.
+  - flags: Ignored.
+
+Output
+  - An io.ReadCloser yielding nothing.
+*/
+func (mockFactory *MockSdkAbstractFactory) ExportEntities(ctx context.Context, flags factory.Flags) (io.ReadCloser, error) {
+	return io.NopCloser(strings.NewReader("")), nil
+}
+
+/*
+The GetAll method returns the results of the GetG2* methods.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The injected mocks, or stubs for those that are nil.
+*/
+func (mockFactory *MockSdkAbstractFactory) GetAll(ctx context.Context) (*factory.G2Objects, error) {
+	result := &factory.G2Objects{}
+	result.G2config, _ = mockFactory.GetG2config(ctx)
+	result.G2configmgr, _ = mockFactory.GetG2configmgr(ctx)
+	result.G2diagnostic, _ = mockFactory.GetG2diagnostic(ctx)
+	result.G2engine, _ = mockFactory.GetG2engine(ctx)
+	result.G2product, _ = mockFactory.GetG2product(ctx)
+	return result, nil
+}
+
+/*
+The GetG2config method returns G2configMock, or a stub if it is nil.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - A G2config object.
+*/
+func (mockFactory *MockSdkAbstractFactory) GetG2config(ctx context.Context) (g2api.G2config, error) {
+	if mockFactory.G2configMock != nil {
+		return mockFactory.G2configMock, nil
+	}
+	return &stubG2config{}, nil
+}
+
+/*
+The GetG2configForConfigID method returns the result of GetG2config and a zero configuration handle.
+
+Input
+  - ctx: A context to control lifecycle.
+  - configID: Ignored.
+
+Output
+  - The G2config object.
+  - A zero configuration handle.
+*/
+func (mockFactory *MockSdkAbstractFactory) GetG2configForConfigID(ctx context.Context, configID int64) (g2api.G2config, uintptr, error) {
+	g2config, err := mockFactory.GetG2config(ctx)
+	return g2config, 0, err
+}
+
+/*
+The GetG2configWithLoad method returns the result of GetG2config and a zero configuration handle.
+
+Input
+  - ctx: A context to control lifecycle.
+  - configID: Ignored.
+
+Output
+  - The G2config object.
+  - A zero configuration handle.
+*/
+func (mockFactory *MockSdkAbstractFactory) GetG2configWithLoad(ctx context.Context, configID int64) (g2api.G2config, uintptr, error) {
+	return mockFactory.GetG2configForConfigID(ctx, configID)
+}
+
+/*
+The GetG2configmgr method returns G2configmgrMock, or a stub if it is nil.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - A G2configmgr object.
+*/
+func (mockFactory *MockSdkAbstractFactory) GetG2configmgr(ctx context.Context) (g2api.G2configmgr, error) {
+	if mockFactory.G2configmgrMock != nil {
+		return mockFactory.G2configmgrMock, nil
+	}
+	return &stubG2configmgr{}, nil
+}
+
+/*
+The GetG2diagnostic method returns G2diagnosticMock, or a stub if it is nil.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - A G2diagnostic object.
+*/
+func (mockFactory *MockSdkAbstractFactory) GetG2diagnostic(ctx context.Context) (g2api.G2diagnostic, error) {
+	if mockFactory.G2diagnosticMock != nil {
+		return mockFactory.G2diagnosticMock, nil
+	}
+	return &stubG2diagnostic{}, nil
+}
+
+/*
+The GetG2engine method returns G2engineMock, or a stub if it is nil.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - A G2engine object.
+*/
+func (mockFactory *MockSdkAbstractFactory) GetG2engine(ctx context.Context) (g2api.G2engine, error) {
+	if mockFactory.G2engineMock != nil {
+		return mockFactory.G2engineMock, nil
+	}
+	return &stubG2engine{}, nil
+}
+
+/*
+The GetG2engineWithConfigID method returns the result of GetG2engine.
+
+Input
+  - ctx: A context to control lifecycle.
+  - configID: Ignored.
+
+Output
+  - A G2engine object.
+*/
+func (mockFactory *MockSdkAbstractFactory) GetG2engineWithConfigID(ctx context.Context, configID int64) (g2api.G2engine, error) {
+	return mockFactory.GetG2engine(ctx)
+}
+
+/*
+The GetG2product method returns G2productMock, or a stub if it is nil.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - A G2product object.
+*/
+func (mockFactory *MockSdkAbstractFactory) GetG2product(ctx context.Context) (g2api.G2product, error) {
+	if mockFactory.G2productMock != nil {
+		return mockFactory.G2productMock, nil
+	}
+	return &stubG2product{}, nil
+}
+
+/*
+The GrpcConnection method reports that a mock has no gRPC connection.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - An error wrapping factory.ErrUnsupportedMode.
+*/
+func (mockFactory *MockSdkAbstractFactory) GrpcConnection(ctx context.Context) (*grpc.ClientConn, error) {
+	return nil, fmt.Errorf("%w: MockSdkAbstractFactory has no gRPC connection", factory.ErrUnsupportedMode)
+}
+
+/*
+The HealthCheck method reports every component as healthy.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (mockFactory *MockSdkAbstractFactory) HealthCheck(ctx context.Context) error {
+	return nil
+}
+
+/*
+The Initialize method does nothing; mocks and stubs need no initialization.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (mockFactory *MockSdkAbstractFactory) Initialize(ctx context.Context) error {
+	return nil
+}
+
+/*
+The IsGrpc method reports whether ModeMock is factory.ModeGrpc.
+
+Output
+  - True for factory.ModeGrpc.
+*/
+func (mockFactory *MockSdkAbstractFactory) IsGrpc() bool {
+	return mockFactory.ModeMock == factory.ModeGrpc
+}
+
+/*
+The License method returns empty license details, which never expire.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - A zero License.
+*/
+func (mockFactory *MockSdkAbstractFactory) License(ctx context.Context) (*factory.License, error) {
+	return &factory.License{}, nil
+}
+
+/*
+The LicenseInfo method returns empty license details.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - A zero LicenseInfo.
+*/
+func (mockFactory *MockSdkAbstractFactory) LicenseInfo(ctx context.Context) (factory.LicenseInfo, error) {
+	return factory.LicenseInfo{}, nil
+}
+
+/*
+The Mode method returns ModeMock.
+
+Output
+  - ModeMock.
+*/
+func (mockFactory *MockSdkAbstractFactory) Mode() factory.FactoryMode {
+	return mockFactory.ModeMock
+}
+
+/*
+The OnClose method ignores hook, since Destroy does nothing.
+
+Input
+  - hook: The function that a real factory would call during Destroy.
+*/
+func (mockFactory *MockSdkAbstractFactory) OnClose(hook func(ctx context.Context) error) {
+}
+
+/*
+The ProcessRedoRecords method drains the redo queue of the G2engine from GetG2engine
+with factory.ProcessRedoRecords.
+
+Input
+  - ctx: A context to control lifecycle.
+  - maxRecords: The most records to process; 0 or less processes until the queue is empty.
+  - withInfo: Whether to use ProcessWithInfo and collect its withInfo JSON.
+
+Output
+  - The number of records processed, and the withInfo JSON of each; none unless G2engineMock is set.
+*/
+func (mockFactory *MockSdkAbstractFactory) ProcessRedoRecords(ctx context.Context, maxRecords int, withInfo bool) (int, []string, error) {
+	g2engine, err := mockFactory.GetG2engine(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+	return factory.ProcessRedoRecords(ctx, g2engine, maxRecords, withInfo, factory.FlagsNone)
+}
+
+/*
+The RegisterObserver method does nothing; register observers on injected mocks directly.
+
+Input
+  - ctx: A context to control lifecycle.
+  - observer: Ignored.
+*/
+func (mockFactory *MockSdkAbstractFactory) RegisterObserver(ctx context.Context, observer observer.Observer) error {
+	return nil
+}
+
+/*
+The Reset method does nothing; injected mocks belong to the caller.
+
+Input
+  - ctx: A context to control lifecycle.
+  - destroy: Ignored.
+*/
+func (mockFactory *MockSdkAbstractFactory) Reset(ctx context.Context, destroy bool) error {
+	return nil
+}
+
+/*
+The SetMode method sets ModeMock.
+
+Input
+  - ctx: A context to control lifecycle.
+  - mode: The mode subsequently reported by Mode and IsGrpc.
+*/
+func (mockFactory *MockSdkAbstractFactory) SetMode(ctx context.Context, mode factory.FactoryMode) error {
+	mockFactory.ModeMock = mode
+	return nil
+}
+
+/*
+The UnregisterObserver method does nothing.
+
+Input
+  - ctx: A context to control lifecycle.
+  - observer: Ignored.
+*/
+func (mockFactory *MockSdkAbstractFactory) UnregisterObserver(ctx context.Context, observer observer.Observer) error {
+	return nil
+}
+
+/*
+The Validate method does nothing; a mock has no configuration to check.
+*/
+func (mockFactory *MockSdkAbstractFactory) Validate() error {
+	return nil
+}
+
+/*
+The VerifyGrpcServices method does nothing.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (mockFactory *MockSdkAbstractFactory) VerifyGrpcServices(ctx context.Context) error {
+	return nil
+}
+
+/*
+The WaitUntilReady method returns at once; a mock is always ready.
+
+Input
+  - ctx: A context to control lifecycle.
+  - timeout: Ignored.
+*/
+func (mockFactory *MockSdkAbstractFactory) WaitUntilReady(ctx context.Context, timeout time.Duration) error {
+	return nil
+}
@@ -0,0 +1,107 @@
+package factorymock
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/senzing/g2-sdk-go/g2api"
+	"github.com/senzing/go-sdk-abstract-factory/factory"
+	"github.com/stretchr/testify/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Test doubles
+// ----------------------------------------------------------------------------
+
+// cannedG2engine returns a fixed response from AddRecordWithInfo.
+type cannedG2engine struct {
+	g2api.G2engine
+	withInfo string
+}
+
+func (g2engine *cannedG2engine) AddRecordWithInfo(ctx context.Context, dataSourceCode string, recordID string, jsonData string, loadID string, flags int64) (string, error) {
+	return g2engine.withInfo, nil
+}
+
+// activeConfigG2engine returns a fixed active configuration identifier.
+type activeConfigG2engine struct {
+	g2api.G2engine
+	activeConfigID int64
+}
+
+func (g2engine *activeConfigG2engine) GetActiveConfigID(ctx context.Context) (int64, error) {
+	return g2engine.activeConfigID, nil
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestMockSdkAbstractFactory_GetG2engine_injected(test *testing.T) {
+	ctx := context.TODO()
+	g2engineMock := &cannedG2engine{}
+	testObject := &MockSdkAbstractFactory{G2engineMock: g2engineMock}
+	g2engine, err := testObject.GetG2engine(ctx)
+	assert.NoError(test, err)
+	assert.Same(test, g2engineMock, g2engine)
+	assert.Equal(test, []interface{}{g2engineMock}, testObject.CreatedObjects(ctx))
+}
+
+func TestMockSdkAbstractFactory_ActiveConfigID(test *testing.T) {
+	ctx := context.TODO()
+	testObject := &MockSdkAbstractFactory{G2engineMock: &activeConfigG2engine{activeConfigID: 4015588140}}
+	actual, err := testObject.ActiveConfigID(ctx)
+	assert.NoError(test, err)
+	assert.Equal(test, int64(4015588140), actual)
+}
+
+func TestMockSdkAbstractFactory_stubs(test *testing.T) {
+	ctx := context.TODO()
+	testObject := &MockSdkAbstractFactory{}
+	g2engine, err := testObject.GetG2engine(ctx)
+	assert.NoError(test, err)
+	activeConfigID, err := g2engine.GetActiveConfigID(ctx)
+	assert.NoError(test, err)
+	assert.Zero(test, activeConfigID)
+	g2product, err := testObject.GetG2product(ctx)
+	assert.NoError(test, err)
+	version, err := g2product.Version(ctx)
+	assert.NoError(test, err)
+	assert.Empty(test, version)
+	diagnosticStats, err := testObject.DiagnosticStats(ctx)
+	assert.NoError(test, err)
+	assert.Zero(test, diagnosticStats)
+	addRecordResult, err := testObject.AddRecord(ctx, "TEST", "1", `{"NAME_FULL": "Robert Smith"}`, factory.FlagsNone)
+	assert.NoError(test, err)
+	assert.Empty(test, addRecordResult.AffectedEntities)
+	_, err = testObject.GrpcConnection(ctx)
+	assert.ErrorIs(test, err, factory.ErrUnsupportedMode)
+	processed, infos, err := testObject.ProcessRedoRecords(ctx, 0, true)
+	assert.NoError(test, err)
+	assert.Zero(test, processed)
+	assert.Empty(test, infos)
+	assert.Empty(test, testObject.CreatedObjects(ctx))
+	assert.NoError(test, testObject.Destroy(ctx))
+}
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleMockSdkAbstractFactory() {
+	ctx := context.TODO()
+	senzingFactory := &MockSdkAbstractFactory{
+		G2engineMock: &cannedG2engine{withInfo: `{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1001"}`},
+	}
+	g2engine, err := senzingFactory.GetG2engine(ctx)
+	if err != nil {
+		fmt.Println(err)
+	}
+	withInfo, err := g2engine.AddRecordWithInfo(ctx, "CUSTOMERS", "1001", `{"NAME_FULL":"Robert Smith"}`, "", 0)
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(withInfo)
+	// Output: {"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1001"}
+}
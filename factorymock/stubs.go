@@ -0,0 +1,165 @@
+package factorymock
+
+import (
+	"context"
+
+	"github.com/senzing/g2-sdk-go/g2api"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// The stubs embed a nil interface so they satisfy it; only the methods below are implemented.
+
+type stubG2config struct {
+	g2api.G2config
+}
+
+type stubG2configmgr struct {
+	g2api.G2configmgr
+}
+
+type stubG2diagnostic struct {
+	g2api.G2diagnostic
+}
+
+type stubG2engine struct {
+	g2api.G2engine
+}
+
+type stubG2product struct {
+	g2api.G2product
+}
+
+// ----------------------------------------------------------------------------
+// stubG2config
+// ----------------------------------------------------------------------------
+
+func (g2config *stubG2config) Close(ctx context.Context, configHandle uintptr) error {
+	return nil
+}
+
+func (g2config *stubG2config) Create(ctx context.Context) (uintptr, error) {
+	return 0, nil
+}
+
+func (g2config *stubG2config) Destroy(ctx context.Context) error {
+	return nil
+}
+
+func (g2config *stubG2config) Init(ctx context.Context, moduleName string, iniParams string, verboseLogging int) error {
+	return nil
+}
+
+func (g2config *stubG2config) Load(ctx context.Context, configHandle uintptr, jsonConfig string) error {
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// stubG2configmgr
+// ----------------------------------------------------------------------------
+
+func (g2configmgr *stubG2configmgr) Destroy(ctx context.Context) error {
+	return nil
+}
+
+func (g2configmgr *stubG2configmgr) GetConfig(ctx context.Context, configID int64) (string, error) {
+	return "", nil
+}
+
+func (g2configmgr *stubG2configmgr) Init(ctx context.Context, moduleName string, iniParams string, verboseLogging int) error {
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// stubG2diagnostic
+// ----------------------------------------------------------------------------
+
+func (g2diagnostic *stubG2diagnostic) Destroy(ctx context.Context) error {
+	return nil
+}
+
+func (g2diagnostic *stubG2diagnostic) GetAvailableMemory(ctx context.Context) (int64, error) {
+	return 0, nil
+}
+
+func (g2diagnostic *stubG2diagnostic) GetLogicalCores(ctx context.Context) (int, error) {
+	return 0, nil
+}
+
+func (g2diagnostic *stubG2diagnostic) GetPhysicalCores(ctx context.Context) (int, error) {
+	return 0, nil
+}
+
+func (g2diagnostic *stubG2diagnostic) GetTotalSystemMemory(ctx context.Context) (int64, error) {
+	return 0, nil
+}
+
+func (g2diagnostic *stubG2diagnostic) Init(ctx context.Context, moduleName string, iniParams string, verboseLogging int) error {
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// stubG2engine
+// ----------------------------------------------------------------------------
+
+func (g2engine *stubG2engine) AddRecord(ctx context.Context, dataSourceCode string, recordID string, jsonData string, loadID string) error {
+	return nil
+}
+
+func (g2engine *stubG2engine) AddRecordWithInfo(ctx context.Context, dataSourceCode string, recordID string, jsonData string, loadID string, flags int64) (string, error) {
+	return "", nil
+}
+
+func (g2engine *stubG2engine) CloseExport(ctx context.Context, responseHandle uintptr) error {
+	return nil
+}
+
+func (g2engine *stubG2engine) Destroy(ctx context.Context) error {
+	return nil
+}
+
+func (g2engine *stubG2engine) ExportJSONEntityReport(ctx context.Context, flags int64) (uintptr, error) {
+	return 0, nil
+}
+
+func (g2engine *stubG2engine) FetchNext(ctx context.Context, responseHandle uintptr) (string, error) {
+	return "", nil
+}
+
+func (g2engine *stubG2engine) GetActiveConfigID(ctx context.Context) (int64, error) {
+	return 0, nil
+}
+
+func (g2engine *stubG2engine) GetRedoRecord(ctx context.Context) (string, error) {
+	return "", nil
+}
+
+func (g2engine *stubG2engine) Init(ctx context.Context, moduleName string, iniParams string, verboseLogging int) error {
+	return nil
+}
+
+func (g2engine *stubG2engine) Stats(ctx context.Context) (string, error) {
+	return "", nil
+}
+
+// ----------------------------------------------------------------------------
+// stubG2product
+// ----------------------------------------------------------------------------
+
+func (g2product *stubG2product) Destroy(ctx context.Context) error {
+	return nil
+}
+
+func (g2product *stubG2product) Init(ctx context.Context, moduleName string, iniParams string, verboseLogging int) error {
+	return nil
+}
+
+func (g2product *stubG2product) License(ctx context.Context) (string, error) {
+	return "", nil
+}
+
+func (g2product *stubG2product) Version(ctx context.Context) (string, error) {
+	return "", nil
+}
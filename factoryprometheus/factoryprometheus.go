@@ -0,0 +1,147 @@
+/*
+Package factoryprometheus records the measurements of a factory.SdkAbstractFactoryImpl in
+Prometheus collectors.  It is separate from the factory package, so that programs that do not
+collect metrics do not depend on Prometheus.
+*/
+package factoryprometheus
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/senzing/go-sdk-abstract-factory/factory"
+	"google.golang.org/grpc/connectivity"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Recorder is a factory.MetricsRecorder that reports one factory's measurements to these collectors:
+
+	senzing_factory_objects_created_total{factory, object, mode}                Senzing objects created by the GetG2* methods.
+	senzing_factory_grpc_connection_state{factory, state}                       1 for the current gRPC connectivity state, 0 for the others.
+	senzing_factory_observer_notifications_dropped_total{factory, observer}     Notifications dropped because an observer's buffer was full.
+
+Recorders registered on the same Registerer share the collectors; the factory label tells
+their factories apart, so each factory needs a Recorder with its own name.
+*/
+type Recorder struct {
+	factoryName                  string
+	grpcConnectionState          *prometheus.GaugeVec
+	objectsCreated               *prometheus.CounterVec
+	observerNotificationsDropped *prometheus.CounterVec
+}
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var _ factory.MetricsRecorder = (*Recorder)(nil)
+
+// The states reported by senzing_factory_grpc_connection_state.
+var grpcConnectionStates = []connectivity.State{
+	connectivity.Idle,
+	connectivity.Connecting,
+	connectivity.Ready,
+	connectivity.TransientFailure,
+	connectivity.Shutdown,
+}
+
+// ----------------------------------------------------------------------------
+// Constructors
+// ----------------------------------------------------------------------------
+
+/*
+The New function creates the collectors and registers them on registerer.
+Collectors already registered by an earlier call are reused.
+
+Input
+  - registerer: The Prometheus registerer, e.g. prometheus.DefaultRegisterer.
+  - factoryName: The value of the factory label, unique among the factories sharing registerer.
+
+Output
+  - A Recorder for factory.WithMetrics.
+*/
+func New(registerer prometheus.Registerer, factoryName string) (*Recorder, error) {
+	if registerer == nil {
+		return nil, fmt.Errorf("prometheus registerer must not be nil")
+	}
+	if len(factoryName) == 0 {
+		return nil, fmt.Errorf("factory name must not be empty")
+	}
+	grpcConnectionState, err := registerCollector(registerer, prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "senzing_factory_grpc_connection_state",
+		Help: "Whether the gRPC connection of a Senzing factory is in the given connectivity state.",
+	}, []string{"factory", "state"}))
+	if err != nil {
+		return nil, err
+	}
+	objectsCreated, err := registerCollector(registerer, prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "senzing_factory_objects_created_total",
+		Help: "Senzing objects created by a Senzing factory.",
+	}, []string{"factory", "object", "mode"}))
+	if err != nil {
+		return nil, err
+	}
+	observerNotificationsDropped, err := registerCollector(registerer, prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "senzing_factory_observer_notifications_dropped_total",
+		Help: "Notifications a Senzing factory dropped because the observer's buffer was full.",
+	}, []string{"factory", "observer"}))
+	if err != nil {
+		return nil, err
+	}
+	result := &Recorder{
+		factoryName:                  factoryName,
+		grpcConnectionState:          grpcConnectionState,
+		objectsCreated:               objectsCreated,
+		observerNotificationsDropped: observerNotificationsDropped,
+	}
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Register collector, or return the equivalent collector registered before.
+func registerCollector[T prometheus.Collector](registerer prometheus.Registerer, collector T) (T, error) {
+	err := registerer.Register(collector)
+	alreadyRegistered := prometheus.AlreadyRegisteredError{}
+	if errors.As(err, &alreadyRegistered) {
+		if existing, ok := alreadyRegistered.ExistingCollector.(T); ok {
+			return existing, nil
+		}
+	}
+	if err != nil {
+		return collector, fmt.Errorf("metrics: %w", err)
+	}
+	return collector, nil
+}
+
+// ----------------------------------------------------------------------------
+// Interface methods
+// ----------------------------------------------------------------------------
+
+// GrpcConnectionStateChanged sets the gauge of the current gRPC connection state to 1 and the others to 0.
+func (recorder *Recorder) GrpcConnectionStateChanged(state connectivity.State) {
+	for _, aState := range grpcConnectionStates {
+		value := 0.0
+		if aState == state {
+			value = 1.0
+		}
+		recorder.grpcConnectionState.WithLabelValues(recorder.factoryName, aState.String()).Set(value)
+	}
+}
+
+// ObjectCreated counts a Senzing object created by a GetG2* method.
+func (recorder *Recorder) ObjectCreated(objectName string, mode factory.FactoryMode) {
+	recorder.objectsCreated.WithLabelValues(recorder.factoryName, objectName, mode.String()).Inc()
+}
+
+// ObserverNotificationDropped counts a notification dropped for the observer identified by observerId.
+func (recorder *Recorder) ObserverNotificationDropped(observerId string) {
+	recorder.observerNotificationsDropped.WithLabelValues(recorder.factoryName, observerId).Inc()
+}
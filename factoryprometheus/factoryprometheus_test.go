@@ -0,0 +1,84 @@
+package factoryprometheus
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/senzing/go-sdk-abstract-factory/factory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/connectivity"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestNew(test *testing.T) {
+	ctx := context.TODO()
+	registry := prometheus.NewRegistry()
+	recorder, err := New(registry, "primary")
+	require.NoError(test, err)
+	testObject, err := factory.New(factory.WithNullBackend(), factory.WithMetrics(recorder))
+	require.NoError(test, err)
+	for i := 0; i < 2; i++ {
+		_, err = testObject.GetG2engine(ctx)
+		require.NoError(test, err)
+	}
+	expected := `
+# HELP senzing_factory_objects_created_total Senzing objects created by a Senzing factory.
+# TYPE senzing_factory_objects_created_total counter
+senzing_factory_objects_created_total{factory="primary",mode="null",object="G2engine"} 1
+`
+	err = testutil.GatherAndCompare(registry, strings.NewReader(expected), "senzing_factory_objects_created_total")
+	assert.NoError(test, err)
+}
+
+func TestNew_sharedRegisterer(test *testing.T) {
+	registry := prometheus.NewRegistry()
+	primary, err := New(registry, "primary")
+	require.NoError(test, err)
+	secondary, err := New(registry, "secondary")
+	require.NoError(test, err)
+
+	// Each factory's connection state is reported separately.
+
+	primary.GrpcConnectionStateChanged(connectivity.Ready)
+	secondary.GrpcConnectionStateChanged(connectivity.TransientFailure)
+	assert.Equal(test, 1.0, testutil.ToFloat64(primary.grpcConnectionState.WithLabelValues("primary", "READY")))
+	assert.Equal(test, 0.0, testutil.ToFloat64(primary.grpcConnectionState.WithLabelValues("primary", "TRANSIENT_FAILURE")))
+	assert.Equal(test, 0.0, testutil.ToFloat64(secondary.grpcConnectionState.WithLabelValues("secondary", "READY")))
+	assert.Equal(test, 1.0, testutil.ToFloat64(secondary.grpcConnectionState.WithLabelValues("secondary", "TRANSIENT_FAILURE")))
+
+	primary.ObjectCreated("G2product", factory.ModeGrpc)
+	secondary.ObjectCreated("G2product", factory.ModeGrpc)
+	secondary.ObserverNotificationDropped("observer1")
+	expected := `
+# HELP senzing_factory_objects_created_total Senzing objects created by a Senzing factory.
+# TYPE senzing_factory_objects_created_total counter
+senzing_factory_objects_created_total{factory="primary",mode="grpc",object="G2product"} 1
+senzing_factory_objects_created_total{factory="secondary",mode="grpc",object="G2product"} 1
+# HELP senzing_factory_observer_notifications_dropped_total Notifications a Senzing factory dropped because the observer's buffer was full.
+# TYPE senzing_factory_observer_notifications_dropped_total counter
+senzing_factory_observer_notifications_dropped_total{factory="secondary",observer="observer1"} 1
+`
+	err = testutil.GatherAndCompare(registry, strings.NewReader(expected), "senzing_factory_objects_created_total", "senzing_factory_observer_notifications_dropped_total")
+	assert.NoError(test, err)
+}
+
+func TestNew_invalid(test *testing.T) {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "senzing_factory_objects_created_total",
+		Help: "Something else.",
+	}))
+	_, err := New(registry, "primary")
+	assert.Error(test, err)
+	_, err = New(nil, "primary")
+	assert.Error(test, err)
+	_, err = New(prometheus.NewRegistry(), "")
+	assert.Error(test, err)
+}
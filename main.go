@@ -178,41 +178,6 @@ func demonstrateAdditionalFunctions(ctx context.Context, g2Diagnostic g2api.G2di
 	return err
 }
 
-func destroyObjects(ctx context.Context, g2Config g2api.G2config, g2Configmgr g2api.G2configmgr, g2Diagnostic g2api.G2diagnostic, g2Engine g2api.G2engine, g2Product g2api.G2product) error {
-	var err error = nil
-
-	// Destroy is only needed for "base" implementation.
-
-	if g2Config.GetSdkId(ctx) == "base" {
-		err = g2Config.Destroy(ctx)
-		if err != nil {
-			failOnError(5401, err)
-		}
-
-		err = g2Configmgr.Destroy(ctx)
-		if err != nil {
-			failOnError(5402, err)
-		}
-
-		err = g2Diagnostic.Destroy(ctx)
-		if err != nil {
-			failOnError(5403, err)
-		}
-
-		err = g2Engine.Destroy(ctx)
-		if err != nil {
-			failOnError(5404, err)
-		}
-
-		err = g2Product.Destroy(ctx)
-		if err != nil {
-			failOnError(5405, err)
-		}
-	}
-
-	return err
-}
-
 func failOnError(msgId int, err error) {
 	logger.Log(msgId, err)
 	panic(err.Error())
@@ -289,15 +254,20 @@ func main() {
 		switch runNumber {
 		case 1:
 			logger.Log(2001, "Local SDK")
-			senzingFactory = &factory.SdkAbstractFactoryImpl{}
+			senzingFactory, err = factory.New(
+				factory.WithModuleName(moduleName),
+				factory.WithEngineConfigurationJson(iniParams),
+				factory.WithVerboseLogging(verboseLogging),
+			)
 		case 2:
 			logger.Log(2001, "gRPC SDK")
-			senzingFactory = &factory.SdkAbstractFactoryImpl{
-				GrpcAddress: "localhost:8258",
-			}
+			senzingFactory, err = factory.New(factory.WithGrpcAddress("localhost:8258"))
 		default:
 			failOnError(5003, fmt.Errorf("unknown testcase number"))
 		}
+		if err != nil {
+			failOnError(5017, err)
+		}
 
 		// Get Senzing objects for installing a Senzing Engine configuration.
 
@@ -314,19 +284,6 @@ func main() {
 		}
 		g2Configmgr.RegisterObserver(ctx, observer1)
 
-		// Initialize "base" implementations.
-
-		if g2Config.GetSdkId(ctx) == "base" {
-			err = g2Config.Init(ctx, moduleName, iniParams, verboseLogging)
-			if err != nil {
-				failOnError(5006, err)
-			}
-			err = g2Configmgr.Init(ctx, moduleName, iniParams, verboseLogging)
-			if err != nil {
-				failOnError(5007, err)
-			}
-		}
-
 		// Persist the Senzing configuration to the Senzing repository.
 
 		err = demonstrateConfigFunctions(ctx, g2Config, g2Configmgr)
@@ -354,23 +311,6 @@ func main() {
 		}
 		g2Product.RegisterObserver(ctx, observer1)
 
-		// Initialize "base" implementations.
-
-		if g2Diagnostic.GetSdkId(ctx) == "base" {
-			err = g2Diagnostic.Init(ctx, moduleName, iniParams, verboseLogging)
-			if err != nil {
-				failOnError(5012, err)
-			}
-			err = g2Engine.Init(ctx, moduleName, iniParams, verboseLogging)
-			if err != nil {
-				failOnError(5013, err)
-			}
-			err = g2Product.Init(ctx, moduleName, iniParams, verboseLogging)
-			if err != nil {
-				failOnError(5014, err)
-			}
-		}
-
 		// Demonstrate tests.
 
 		err = demonstrateAdditionalFunctions(ctx, g2Diagnostic, g2Engine, g2Product)
@@ -380,7 +320,7 @@ func main() {
 
 		// Destroy Senzing objects.
 
-		err = destroyObjects(ctx, g2Config, g2Configmgr, g2Diagnostic, g2Engine, g2Product)
+		err = senzingFactory.Destroy(ctx)
 		if err != nil {
 			failOnError(5016, err)
 		}